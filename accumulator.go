@@ -0,0 +1,341 @@
+package collection
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Accumulator consumes a stream of values one at a time.
+type Accumulator interface {
+	// Add feeds the value to the accumulator.
+	Add(v float64)
+}
+
+// ChannelAccumulate feeds every value received from the channel to the accumulators until the channel is closed.
+func ChannelAccumulate[T Number](source <-chan T, accumulators ...Accumulator) {
+	for v := range source {
+		for _, acc := range accumulators {
+			acc.Add(float64(v))
+		}
+	}
+}
+
+// SeqAccumulate feeds every value of the sequence to the accumulators.
+func SeqAccumulate[T Number](seq func(yield func(T) bool), accumulators ...Accumulator) {
+	seq(func(v T) bool {
+		for _, acc := range accumulators {
+			acc.Add(float64(v))
+		}
+
+		return true
+	})
+}
+
+// StatsSnapshot is a point-in-time view of a RunningStats.
+type StatsSnapshot struct {
+	Count    int
+	Mean     float64
+	Variance float64
+	Min      float64
+	Max      float64
+}
+
+// StdDev returns the population standard deviation of the snapshot.
+func (s StatsSnapshot) StdDev() float64 {
+	return math.Sqrt(s.Variance)
+}
+
+// RunningStats computes the count, mean, variance, minimum and maximum of a stream in constant memory
+// using Welford's algorithm. The zero value is ready to use and it is safe for concurrent use.
+type RunningStats struct {
+	mu    sync.Mutex
+	count int
+	mean  float64
+	m2    float64
+	min   float64
+	max   float64
+}
+
+// Add feeds the value to the statistics.
+func (s *RunningStats) Add(v float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.count++
+	var delta = v - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (v - s.mean)
+
+	if s.count == 1 || v < s.min {
+		s.min = v
+	}
+
+	if s.count == 1 || v > s.max {
+		s.max = v
+	}
+}
+
+// Merge adds the values seen by other to s, as if they had been fed to s directly.
+func (s *RunningStats) Merge(other *RunningStats) {
+	var o = other.Snapshot()
+	if o.Count == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.count == 0 {
+		s.count, s.mean, s.m2, s.min, s.max = o.Count, o.Mean, o.Variance*float64(o.Count), o.Min, o.Max
+		return
+	}
+
+	var count = s.count + o.Count
+	var delta = o.Mean - s.mean
+	s.m2 += o.Variance*float64(o.Count) + delta*delta*float64(s.count)*float64(o.Count)/float64(count)
+	s.mean += delta * float64(o.Count) / float64(count)
+	s.count = count
+	s.min = math.Min(s.min, o.Min)
+	s.max = math.Max(s.max, o.Max)
+}
+
+// Snapshot returns the current statistics.
+func (s *RunningStats) Snapshot() StatsSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result = StatsSnapshot{Count: s.count, Mean: s.mean, Min: s.min, Max: s.max}
+	if s.count > 0 {
+		result.Variance = s.m2 / float64(s.count)
+	}
+
+	return result
+}
+
+// EMA computes an exponential moving average of a stream. It is safe for concurrent use.
+type EMA struct {
+	mu    sync.Mutex
+	alpha float64
+	value float64
+	seen  bool
+}
+
+// NewEMA creates an exponential moving average with the smoothing factor alpha, giving the weight of the newest value.
+// It panics if alpha is not in (0, 1].
+func NewEMA(alpha float64) *EMA {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("collection: EMA alpha must be in (0, 1]")
+	}
+
+	return &EMA{alpha: alpha}
+}
+
+// Add feeds the value to the average. The first value initialises it.
+func (e *EMA) Add(v float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.seen {
+		e.value, e.seen = v, true
+		return
+	}
+
+	e.value += e.alpha * (v - e.value)
+}
+
+// Value returns the current average. The ok result is false if no value has been added yet.
+func (e *EMA) Value() (float64, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.value, e.seen
+}
+
+const defaultTDigestCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest estimates quantiles of a stream in bounded memory. Digests built by different workers can be merged.
+// Accuracy is highest near the tails and grows with the compression. The zero value uses a compression of 100
+// and it is safe for concurrent use.
+type TDigest struct {
+	mu          sync.Mutex
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+// NewTDigest creates a TDigest with the given compression, the default one is used if it is not positive.
+func NewTDigest(compression float64) *TDigest {
+	return &TDigest{compression: compression}
+}
+
+// Add feeds the value to the digest.
+func (d *TDigest) Add(v float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.add(centroid{mean: v, weight: 1})
+}
+
+func (d *TDigest) add(c centroid) {
+	if d.count == 0 || c.mean < d.min {
+		d.min = c.mean
+	}
+
+	if d.count == 0 || c.mean > d.max {
+		d.max = c.mean
+	}
+
+	d.count += c.weight
+	d.buffer = append(d.buffer, c)
+
+	if len(d.buffer) >= 5*int(d.delta()) {
+		d.compress()
+	}
+}
+
+func (d *TDigest) delta() float64 {
+	if d.compression <= 0 {
+		return defaultTDigestCompression
+	}
+
+	return d.compression
+}
+
+// compress merges the buffered values into the centroids using the k1 scale function.
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+
+	var all = append(d.centroids, d.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	var delta = d.delta()
+	var limit = func(q float64) float64 {
+		var k = delta/(2*math.Pi)*math.Asin(2*q-1) + 1
+		if k >= delta/4 {
+			return 1
+		}
+
+		return (math.Sin(k*2*math.Pi/delta) + 1) / 2
+	}
+
+	var result = make([]centroid, 0, len(all))
+	var current = all[0]
+	var q0 float64
+	var qLimit = limit(q0)
+
+	for _, c := range all[1:] {
+		if q0+(current.weight+c.weight)/d.count <= qLimit {
+			current.weight += c.weight
+			current.mean += (c.mean - current.mean) * c.weight / current.weight
+			continue
+		}
+
+		result = append(result, current)
+		q0 += current.weight / d.count
+		qLimit = limit(q0)
+		current = c
+	}
+
+	d.centroids = append(result, current)
+	d.buffer = d.buffer[:0]
+}
+
+// Merge adds the values seen by other to d.
+func (d *TDigest) Merge(other *TDigest) {
+	other.mu.Lock()
+	other.compress()
+	var centroids = append([]centroid(nil), other.centroids...)
+	var lo, hi = other.min, other.max
+	other.mu.Unlock()
+
+	if len(centroids) == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, c := range centroids {
+		d.add(c)
+	}
+
+	d.min = math.Min(d.min, lo)
+	d.max = math.Max(d.max, hi)
+}
+
+// Count returns the number of values fed to the digest.
+func (d *TDigest) Count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return int(d.count)
+}
+
+// Quantile returns the estimated q-th quantile of the values, for q in [0, 1].
+// The ok result is false if the digest is empty or q is out of range.
+func (d *TDigest) Quantile(q float64) (float64, bool) {
+	if !(q >= 0 && q <= 1) {
+		return 0, false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.count == 0 {
+		return 0, false
+	}
+
+	d.compress()
+
+	var index = q * d.count
+	var first, last = d.centroids[0], d.centroids[len(d.centroids)-1]
+
+	if index <= first.weight/2 {
+		return d.min + (first.mean-d.min)*index/(first.weight/2), true
+	}
+
+	if index >= d.count-last.weight/2 {
+		return last.mean + (d.max-last.mean)*(index-(d.count-last.weight/2))/(last.weight/2), true
+	}
+
+	var cumulative = first.weight / 2
+	for i := 1; i < len(d.centroids); i++ {
+		var prev, next = d.centroids[i-1], d.centroids[i]
+		var step = (prev.weight + next.weight) / 2
+
+		if index <= cumulative+step {
+			return prev.mean + (next.mean-prev.mean)*(index-cumulative)/step, true
+		}
+
+		cumulative += step
+	}
+
+	return d.max, true
+}
+
+// Clone returns an independent copy of the digest, which can be queried while d keeps accumulating.
+func (d *TDigest) Clone() *TDigest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.compress()
+
+	return &TDigest{
+		compression: d.compression,
+		centroids:   append([]centroid(nil), d.centroids...),
+		count:       d.count,
+		min:         d.min,
+		max:         d.max,
+	}
+}
@@ -0,0 +1,247 @@
+package collection_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestRunningStats(t *testing.T) {
+	var stats collection.RunningStats
+
+	if got := stats.Snapshot(); got != (collection.StatsSnapshot{}) {
+		t.Errorf("Snapshot() of empty stats = %+v; want zero", got)
+	}
+
+	source := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	for _, v := range source {
+		stats.Add(v)
+	}
+
+	got := stats.Snapshot()
+	want := collection.StatsSnapshot{Count: 8, Mean: 5, Variance: 4, Min: 2, Max: 9}
+	if got.Count != want.Count || !approxEqual(got.Mean, want.Mean) || !approxEqual(got.Variance, want.Variance) || got.Min != want.Min || got.Max != want.Max {
+		t.Errorf("Snapshot() = %+v; want %+v", got, want)
+	}
+
+	if !approxEqual(got.StdDev(), 2) {
+		t.Errorf("StdDev() = %v; want 2", got.StdDev())
+	}
+}
+
+func TestRunningStatsMerge(t *testing.T) {
+	source := randomInts(1000)
+
+	var whole, left, right, empty collection.RunningStats
+	for i, v := range source {
+		whole.Add(float64(v))
+		if i < 300 {
+			left.Add(float64(v))
+		} else {
+			right.Add(float64(v))
+		}
+	}
+
+	empty.Merge(&left)
+	empty.Merge(&right)
+	left.Merge(&right)
+
+	want := whole.Snapshot()
+	for name, stats := range map[string]*collection.RunningStats{"into empty": &empty, "into partial": &left} {
+		got := stats.Snapshot()
+		if got.Count != want.Count || math.Abs(got.Mean-want.Mean) > 1e-6 || math.Abs(got.Variance-want.Variance)/want.Variance > 1e-9 ||
+			got.Min != want.Min || got.Max != want.Max {
+			t.Errorf("%s: Snapshot() = %+v; want %+v", name, got, want)
+		}
+	}
+}
+
+func TestEMA(t *testing.T) {
+	ema := collection.NewEMA(0.5)
+
+	if _, ok := ema.Value(); ok {
+		t.Errorf("Value() of empty EMA ok = true; want false")
+	}
+
+	for _, v := range []float64{10, 20, 30} {
+		ema.Add(v)
+	}
+
+	if got, ok := ema.Value(); !ok || got != 22.5 {
+		t.Errorf("Value() = %v, %v; want 22.5, true", got, ok)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewEMA(0) did not panic")
+		}
+	}()
+	collection.NewEMA(0)
+}
+
+func TestTDigest(t *testing.T) {
+	var digest collection.TDigest
+
+	if _, ok := digest.Quantile(0.5); ok {
+		t.Errorf("Quantile() of empty digest ok = true; want false")
+	}
+
+	for _, v := range []float64{5, 1, 4, 2, 3} {
+		digest.Add(v)
+	}
+
+	for q, want := range map[float64]float64{0: 1, 0.5: 3, 1: 5} {
+		if got, ok := digest.Quantile(q); !ok || got != want {
+			t.Errorf("Quantile(%v) = %v, %v; want %v, true", q, got, ok, want)
+		}
+	}
+
+	if _, ok := digest.Quantile(1.5); ok {
+		t.Errorf("Quantile(1.5) ok = true; want false")
+	}
+}
+
+func TestTDigestAccuracy(t *testing.T) {
+	const n = 100000
+
+	digest := collection.NewTDigest(100)
+	for _, v := range rand.Perm(n) {
+		digest.Add(float64(v))
+	}
+
+	if got := digest.Count(); got != n {
+		t.Errorf("Count() = %v; want %v", got, n)
+	}
+
+	for _, q := range []float64{0.001, 0.01, 0.25, 0.5, 0.75, 0.99, 0.999} {
+		got, _ := digest.Quantile(q)
+		if want := q * n; math.Abs(got-want) > 0.01*n {
+			t.Errorf("Quantile(%v) = %v; want %v within 1%%", q, got, want)
+		}
+	}
+}
+
+func TestTDigestMerge(t *testing.T) {
+	const n, workers = 100000, 4
+
+	values := rand.Perm(n)
+	digests := make([]*collection.TDigest, workers)
+
+	var wg sync.WaitGroup
+	for w := range digests {
+		digests[w] = collection.NewTDigest(100)
+
+		wg.Add(1)
+		go func(d *collection.TDigest, part []int) {
+			defer wg.Done()
+			for _, v := range part {
+				d.Add(float64(v))
+			}
+		}(digests[w], values[w*n/workers:(w+1)*n/workers])
+	}
+	wg.Wait()
+
+	var merged collection.TDigest
+	for _, d := range digests {
+		merged.Merge(d)
+	}
+
+	if got := merged.Count(); got != n {
+		t.Errorf("Count() = %v; want %v", got, n)
+	}
+
+	for _, q := range []float64{0, 0.01, 0.5, 0.99, 1} {
+		got, _ := merged.Quantile(q)
+		if want := q * (n - 1); math.Abs(got-want) > 0.01*n {
+			t.Errorf("Quantile(%v) = %v; want %v within 1%%", q, got, want)
+		}
+	}
+}
+
+func TestTDigestClone(t *testing.T) {
+	digest := collection.NewTDigest(0)
+	for _, v := range []float64{1, 2, 3} {
+		digest.Add(v)
+	}
+
+	snapshot := digest.Clone()
+	digest.Add(100)
+
+	if got, _ := snapshot.Quantile(1); got != 3 {
+		t.Errorf("Clone().Quantile(1) = %v; want 3", got)
+	}
+
+	if got, _ := digest.Quantile(1); got != 100 {
+		t.Errorf("Quantile(1) = %v; want 100", got)
+	}
+}
+
+func TestChannelAccumulate(t *testing.T) {
+	sources := make([]chan int, 3)
+	for i := range sources {
+		sources[i] = make(chan int)
+		go func(ch chan<- int, offset int) {
+			defer close(ch)
+			for v := 1; v <= 10; v++ {
+				ch <- offset + v
+			}
+		}(sources[i], i*10)
+	}
+
+	var stats collection.RunningStats
+	digest := collection.NewTDigest(100)
+	collection.ChannelAccumulate(collection.ChannelsMerge(sources[0], sources[1], sources[2]), &stats, digest)
+
+	got := stats.Snapshot()
+	if got.Count != 30 || !approxEqual(got.Mean, 15.5) || got.Min != 1 || got.Max != 30 {
+		t.Errorf("Snapshot() = %+v; want count 30, mean 15.5, min 1, max 30", got)
+	}
+
+	if median, _ := digest.Quantile(0.5); median != 15.5 {
+		t.Errorf("Quantile(0.5) = %v; want 15.5", median)
+	}
+}
+
+func TestSeqAccumulate(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		for v := 1; v <= 4; v++ {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	ema := collection.NewEMA(1)
+	var stats collection.RunningStats
+	collection.SeqAccumulate(seq, ema, &stats)
+
+	if got, _ := ema.Value(); got != 4 {
+		t.Errorf("EMA Value() = %v; want 4", got)
+	}
+
+	if got := stats.Snapshot(); got.Count != 4 || got.Mean != 2.5 {
+		t.Errorf("Snapshot() = %+v; want count 4, mean 2.5", got)
+	}
+}
+
+// ExampleRunningStats: Example function demonstrating the use of RunningStats over a channel.
+func ExampleRunningStats() {
+	source := make(chan int)
+	go func() {
+		defer close(source)
+		for _, v := range []int{3, 1, 4, 1, 5} {
+			source <- v
+		}
+	}()
+
+	var stats collection.RunningStats
+	collection.ChannelAccumulate(source, &stats)
+
+	snapshot := stats.Snapshot()
+	fmt.Println(snapshot.Count, snapshot.Mean, snapshot.Min, snapshot.Max)
+	// Output: 5 2.8 1 5
+}
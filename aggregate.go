@@ -2,7 +2,15 @@ package collection
 
 // Aggregate aggregates the elements of the slice into a single value using a user-defined aggregator function.
 func Aggregate[S ~[]T, T, K any](source S, aggregator func(K, T) K) K {
-	var result K
+	var seed K
+
+	return AggregateWithSeed(source, seed, aggregator)
+}
+
+// AggregateWithSeed aggregates the elements of the slice into a single value using a user-defined aggregator function,
+// starting from the given seed.
+func AggregateWithSeed[S ~[]T, T, K any](source S, seed K, aggregator func(K, T) K) K {
+	var result = seed
 
 	for _, v := range source {
 		result = aggregator(result, v)
@@ -10,3 +18,63 @@ func Aggregate[S ~[]T, T, K any](source S, aggregator func(K, T) K) K {
 
 	return result
 }
+
+// AggregateRight aggregates the elements of the slice from the last to the first into a single value
+// using a user-defined aggregator function, starting from the given seed.
+func AggregateRight[S ~[]T, T, K any](source S, seed K, aggregator func(K, T) K) K {
+	var result = seed
+
+	for i := len(source) - 1; i >= 0; i-- {
+		result = aggregator(result, source[i])
+	}
+
+	return result
+}
+
+// Scan returns a new slice with the intermediate results of aggregating the elements of the slice,
+// starting from the given seed. The last element of the result is the value returned by AggregateWithSeed.
+func Scan[S ~[]T, T, K any](source S, seed K, aggregator func(K, T) K) []K {
+	var result = make([]K, len(source))
+	var current = seed
+
+	for i, v := range source {
+		current = aggregator(current, v)
+		result[i] = current
+	}
+
+	return result
+}
+
+// TryAggregate aggregates the elements of the slice starting from the given seed using an aggregator function that may fail.
+// It stops at the first error and returns it.
+func TryAggregate[S ~[]T, T, K any](source S, seed K, aggregator func(K, T) (K, error)) (K, error) {
+	var result = seed
+
+	for _, v := range source {
+		var next, err = aggregator(result, v)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+
+		result = next
+	}
+
+	return result, nil
+}
+
+// AggregateUntil aggregates the elements of the slice starting from the given seed until the stop function
+// reports true for the accumulated value, skipping the remaining elements.
+func AggregateUntil[S ~[]T, T, K any](source S, seed K, aggregator func(K, T) K, stop func(K) bool) K {
+	var result = seed
+
+	for _, v := range source {
+		if stop(result) {
+			break
+		}
+
+		result = aggregator(result, v)
+	}
+
+	return result
+}
@@ -1,7 +1,9 @@
 package collection_test
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/sergeydobrodey/collection"
@@ -42,3 +44,127 @@ func ExampleAggregate() {
 	fmt.Println(result)
 	// Output: 15
 }
+
+func TestAggregateWithSeed(t *testing.T) {
+	product := func(p int, v int) int { return p * v }
+
+	if got := collection.AggregateWithSeed([]int{2, 3, 4}, 1, product); got != 24 {
+		t.Errorf("AggregateWithSeed(product) = %v; want 24", got)
+	}
+
+	if got := collection.AggregateWithSeed([]int{}, 1, product); got != 1 {
+		t.Errorf("AggregateWithSeed(empty) = %v; want 1", got)
+	}
+
+	join := func(s string, v string) string { return s + "/" + v }
+	if got := collection.AggregateWithSeed([]string{"usr", "local"}, "", join); got != "/usr/local" {
+		t.Errorf("AggregateWithSeed(join) = %q; want %q", got, "/usr/local")
+	}
+}
+
+func TestAggregateRight(t *testing.T) {
+	join := func(s string, v string) string { return s + v }
+
+	if got := collection.AggregateRight([]string{"a", "b", "c"}, ">", join); got != ">cba" {
+		t.Errorf("AggregateRight = %q; want %q", got, ">cba")
+	}
+
+	if got := collection.AggregateRight([]string{}, ">", join); got != ">" {
+		t.Errorf("AggregateRight(empty) = %q; want %q", got, ">")
+	}
+}
+
+func TestScan(t *testing.T) {
+	sum := func(s int, v int) int { return s + v }
+
+	cases := []struct {
+		source []int
+		seed   int
+		want   []int
+	}{
+		{source: nil, seed: 10, want: []int{}},
+		{source: []int{1, 2, 3, 4}, seed: 0, want: []int{1, 3, 6, 10}},
+		{source: []int{1, 2, 3}, seed: 10, want: []int{11, 13, 16}},
+	}
+
+	for _, tc := range cases {
+		got := collection.Scan(tc.source, tc.seed, sum)
+
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("Scan(%v, %v) = %v; want %v", tc.source, tc.seed, got, tc.want)
+		}
+	}
+}
+
+func TestTryAggregate(t *testing.T) {
+	errNegative := errors.New("negative")
+	sum := func(s int, v int) (int, error) {
+		if v < 0 {
+			return s, errNegative
+		}
+
+		return s + v, nil
+	}
+
+	cases := []struct {
+		name    string
+		source  []int
+		want    int
+		wantErr error
+	}{
+		{name: "empty", source: nil, want: 100},
+		{name: "success", source: []int{1, 2, 3}, want: 106},
+		{name: "failure", source: []int{1, -2, 3}, want: 0, wantErr: errNegative},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := collection.TryAggregate(tc.source, 100, sum)
+
+			if got != tc.want || !errors.Is(err, tc.wantErr) {
+				t.Errorf("TryAggregate(%v) = %v, %v; want %v, %v", tc.source, got, err, tc.want, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAggregateUntil(t *testing.T) {
+	var calls int
+	sum := func(s int, v int) int {
+		calls++
+		return s + v
+	}
+	atLeast := func(limit int) func(int) bool {
+		return func(s int) bool { return s >= limit }
+	}
+
+	cases := []struct {
+		name      string
+		seed      int
+		limit     int
+		want      int
+		wantCalls int
+	}{
+		{name: "stops early", seed: 0, limit: 5, want: 6, wantCalls: 3},
+		{name: "never stops", seed: 0, limit: 100, want: 15, wantCalls: 5},
+		{name: "seed satisfies", seed: 7, limit: 5, want: 7, wantCalls: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = 0
+			got := collection.AggregateUntil([]int{1, 2, 3, 4, 5}, tc.seed, sum, atLeast(tc.limit))
+
+			if got != tc.want || calls != tc.wantCalls {
+				t.Errorf("AggregateUntil = %v after %v calls; want %v after %v calls", got, calls, tc.want, tc.wantCalls)
+			}
+		})
+	}
+}
+
+// ExampleScan: Example function demonstrating the use of the Scan function.
+func ExampleScan() {
+	balance := collection.Scan([]int{100, -30, 50, -20}, 0, func(s int, v int) int { return s + v })
+	fmt.Println(balance)
+	// Output: [100 70 120 100]
+}
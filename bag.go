@@ -0,0 +1,141 @@
+package collection
+
+// Bag is a multiset counting the occurrences of its elements.
+type Bag[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// NewBag creates an empty Bag.
+func NewBag[T comparable]() *Bag[T] {
+	return &Bag[T]{counts: make(map[T]int)}
+}
+
+// BagFrom creates a Bag with the elements of the source slice.
+func BagFrom[S ~[]T, T comparable](source S) *Bag[T] {
+	return &Bag[T]{counts: Frequencies(source), size: len(source)}
+}
+
+// Add adds n occurrences of the value and returns its new count. A non-positive n does nothing.
+func (b *Bag[T]) Add(v T, n int) int {
+	if n > 0 {
+		b.counts[v] += n
+		b.size += n
+	}
+
+	return b.counts[v]
+}
+
+// Remove removes up to n occurrences of the value and returns the number of occurrences removed.
+func (b *Bag[T]) Remove(v T, n int) int {
+	var count = b.counts[v]
+	if n <= 0 || count == 0 {
+		return 0
+	}
+
+	if n >= count {
+		n = count
+		delete(b.counts, v)
+	} else {
+		b.counts[v] = count - n
+	}
+
+	b.size -= n
+
+	return n
+}
+
+// Count returns the number of occurrences of the value.
+func (b *Bag[T]) Count(v T) int {
+	return b.counts[v]
+}
+
+// Contains returns true if the value occurs at least once.
+func (b *Bag[T]) Contains(v T) bool {
+	return b.counts[v] > 0
+}
+
+// Len returns the total number of occurrences of all elements.
+func (b *Bag[T]) Len() int {
+	return b.size
+}
+
+// Distinct returns a new slice with every element of the bag once. Order is not guaranteed.
+func (b *Bag[T]) Distinct() []T {
+	return MapKeys(b.counts)
+}
+
+// MostCommon returns the k elements with the highest counts, from the most to the least common.
+// The order of elements with equal counts is not guaranteed.
+func (b *Bag[T]) MostCommon(k int) []KV[T, int] {
+	return TopKBy(b.Entries(), k, func(l, r KV[T, int]) bool {
+		return l.Value < r.Value
+	})
+}
+
+// Entries returns a new slice of the elements with their counts. Order is not guaranteed.
+func (b *Bag[T]) Entries() []KV[T, int] {
+	return MapToSlice(b.counts, func(v T, count int) KV[T, int] {
+		return KV[T, int]{Key: v, Value: count}
+	})
+}
+
+// Range calls f sequentially for each distinct element with its count.
+// If f returns false, range stops the iteration.
+func (b *Bag[T]) Range(f func(v T, count int) bool) {
+	for v, count := range b.counts {
+		if !f(v, count) {
+			return
+		}
+	}
+}
+
+// ToMap returns a new map of the elements to their counts.
+func (b *Bag[T]) ToMap() map[T]int {
+	return MapClone(b.counts)
+}
+
+// Clone returns a copy of the bag.
+func (b *Bag[T]) Clone() *Bag[T] {
+	return &Bag[T]{counts: MapClone(b.counts), size: b.size}
+}
+
+// Union returns a new Bag with every element counted as the larger of its counts in b and other.
+func (b *Bag[T]) Union(other *Bag[T]) *Bag[T] {
+	var result = b.Clone()
+	for v, count := range other.counts {
+		result.Add(v, count-result.counts[v])
+	}
+
+	return result
+}
+
+// Intersection returns a new Bag with every element counted as the smaller of its counts in b and other.
+func (b *Bag[T]) Intersection(other *Bag[T]) *Bag[T] {
+	var result = NewBag[T]()
+	for v, count := range b.counts {
+		result.Add(v, Min(count, other.counts[v]))
+	}
+
+	return result
+}
+
+// Sum returns a new Bag with the counts of b and other added up.
+func (b *Bag[T]) Sum(other *Bag[T]) *Bag[T] {
+	var result = b.Clone()
+	for v, count := range other.counts {
+		result.Add(v, count)
+	}
+
+	return result
+}
+
+// Difference returns a new Bag with the counts of other subtracted from the counts of b.
+func (b *Bag[T]) Difference(other *Bag[T]) *Bag[T] {
+	var result = b.Clone()
+	for v, count := range other.counts {
+		result.Remove(v, count)
+	}
+
+	return result
+}
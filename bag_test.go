@@ -0,0 +1,95 @@
+package collection_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestBag(t *testing.T) {
+	b := collection.NewBag[string]()
+
+	if got := b.Add("a", 2); got != 2 {
+		t.Errorf("Add(a, 2) = %v; want 2", got)
+	}
+
+	b.Add("b", 1)
+	b.Add("c", 0)
+
+	if b.Len() != 3 || b.Count("a") != 2 || b.Contains("c") {
+		t.Errorf("Len(), Count(a), Contains(c) = %v, %v, %v; want 3, 2, false", b.Len(), b.Count("a"), b.Contains("c"))
+	}
+
+	if removed := b.Remove("a", 5); removed != 2 || b.Contains("a") || b.Len() != 1 {
+		t.Errorf("Remove(a, 5) = %v, Len() = %v; want 2, 1", removed, b.Len())
+	}
+
+	if removed := b.Remove("z", 1); removed != 0 {
+		t.Errorf("Remove(z, 1) = %v; want 0", removed)
+	}
+
+	distinct := collection.BagFrom([]string{"x", "y", "x"}).Distinct()
+	sort.Strings(distinct)
+
+	if !slices.Equal(distinct, []string{"x", "y"}) {
+		t.Errorf("Distinct() = %v; want %v", distinct, []string{"x", "y"})
+	}
+}
+
+func TestBagMostCommon(t *testing.T) {
+	b := collection.BagFrom([]string{"a", "b", "b", "c", "c", "c"})
+
+	want := []collection.KV[string, int]{{"c", 3}, {"b", 2}}
+	if got := b.MostCommon(2); !slices.Equal(got, want) {
+		t.Errorf("MostCommon(2) = %v; want %v", got, want)
+	}
+}
+
+func TestBagOperations(t *testing.T) {
+	a := collection.BagFrom([]string{"x", "x", "y"})
+	b := collection.BagFrom([]string{"x", "y", "y", "z"})
+
+	cases := []struct {
+		name string
+		got  *collection.Bag[string]
+		want map[string]int
+	}{
+		{"union", a.Union(b), map[string]int{"x": 2, "y": 2, "z": 1}},
+		{"intersection", a.Intersection(b), map[string]int{"x": 1, "y": 1}},
+		{"sum", a.Sum(b), map[string]int{"x": 3, "y": 3, "z": 1}},
+		{"difference", a.Difference(b), map[string]int{"x": 1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.got.ToMap(); !maps.Equal(got, tc.want) {
+				t.Errorf("%s = %v; want %v", tc.name, got, tc.want)
+			}
+
+			size := 0
+			tc.got.Range(func(_ string, count int) bool {
+				size += count
+				return true
+			})
+
+			if size != tc.got.Len() {
+				t.Errorf("%s Len() = %v; want %v", tc.name, tc.got.Len(), size)
+			}
+		})
+	}
+
+	if got := a.ToMap(); !maps.Equal(got, map[string]int{"x": 2, "y": 1}) {
+		t.Errorf("operations modified the receiver: %v", got)
+	}
+}
+
+func ExampleBag_MostCommon() {
+	words := collection.BagFrom([]string{"go", "is", "fun", "go", "go", "is"})
+
+	fmt.Println(words.MostCommon(2))
+	// Output: [{go 3} {is 2}]
+}
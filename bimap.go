@@ -0,0 +1,201 @@
+package collection
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrBiMapConflict is returned by BiMap.Put when the key or the value is already bound to another entry.
+var ErrBiMapConflict = errors.New("collection: bimap conflict")
+
+// BiMap is a bidirectional map enforcing unique keys and unique values.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+// NewBiMap creates an empty BiMap.
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), backward: make(map[V]K)}
+}
+
+// SliceToBiMap convert the source slice of type T to a new BiMap with keys and values generated by the provided functions.
+// It returns ErrBiMapConflict if two elements produce the same key or the same value with a different counterpart.
+func SliceToBiMap[S ~[]T, T any, K comparable, V comparable](source S, keyFunc func(T) K, valueFunc func(T) V) (*BiMap[K, V], error) {
+	var result = NewBiMap[K, V]()
+	for _, item := range source {
+		if err := result.Put(keyFunc(item), valueFunc(item)); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Put binds the key and the value. It returns ErrBiMapConflict if either of them is already bound to another entry.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	var current, keyBound = m.forward[key]
+	if keyBound && current == value {
+		return nil
+	}
+
+	if _, valueBound := m.backward[value]; keyBound || valueBound {
+		return ErrBiMapConflict
+	}
+
+	m.forward[key] = value
+	m.backward[value] = key
+
+	return nil
+}
+
+// ForcePut binds the key and the value, removing any entry either of them was bound to.
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	m.DeleteByKey(key)
+	m.DeleteByValue(value)
+
+	m.forward[key] = value
+	m.backward[value] = key
+}
+
+// GetByKey returns the value bound to the key.
+// The ok result indicates whether the key was found.
+func (m *BiMap[K, V]) GetByKey(key K) (value V, ok bool) {
+	value, ok = m.forward[key]
+	return value, ok
+}
+
+// GetByValue returns the key bound to the value.
+// The ok result indicates whether the value was found.
+func (m *BiMap[K, V]) GetByValue(value V) (key K, ok bool) {
+	key, ok = m.backward[value]
+	return key, ok
+}
+
+// ContainsKey returns true if the key is present in the map.
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+// ContainsValue returns true if the value is present in the map.
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := m.backward[value]
+	return ok
+}
+
+// DeleteByKey removes the entry of the key and reports whether it was present.
+func (m *BiMap[K, V]) DeleteByKey(key K) bool {
+	var value, ok = m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.backward, value)
+	}
+
+	return ok
+}
+
+// DeleteByValue removes the entry of the value and reports whether it was present.
+func (m *BiMap[K, V]) DeleteByValue(value V) bool {
+	var key, ok = m.backward[value]
+	if ok {
+		delete(m.forward, key)
+		delete(m.backward, value)
+	}
+
+	return ok
+}
+
+// Len returns the number of entries in the map.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Keys returns a new slice containing all keys in the map. Order is not guaranteed.
+func (m *BiMap[K, V]) Keys() []K {
+	return MapKeys(m.forward)
+}
+
+// Values returns a new slice containing all values in the map. Order is not guaranteed.
+func (m *BiMap[K, V]) Values() []V {
+	return MapKeys(m.backward)
+}
+
+// Inverse returns a view of the map with keys and values swapped. Changes to the view are visible in the map and vice versa.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: m.backward, backward: m.forward}
+}
+
+// ToMap returns a new map with the entries of the BiMap.
+func (m *BiMap[K, V]) ToMap() map[K]V {
+	return MapClone(m.forward)
+}
+
+// SafeBiMap is a BiMap safe for concurrent use.
+type SafeBiMap[K comparable, V comparable] struct {
+	mu *sync.RWMutex
+	m  *BiMap[K, V]
+}
+
+func NewSafeBiMap[K comparable, V comparable]() *SafeBiMap[K, V] {
+	return &SafeBiMap[K, V]{mu: new(sync.RWMutex), m: NewBiMap[K, V]()}
+}
+
+func (s *SafeBiMap[K, V]) Put(key K, value V) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Put(key, value)
+}
+
+func (s *SafeBiMap[K, V]) ForcePut(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.ForcePut(key, value)
+}
+
+func (s *SafeBiMap[K, V]) GetByKey(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.GetByKey(key)
+}
+
+func (s *SafeBiMap[K, V]) GetByValue(value V) (K, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.GetByValue(value)
+}
+
+func (s *SafeBiMap[K, V]) DeleteByKey(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.DeleteByKey(key)
+}
+
+func (s *SafeBiMap[K, V]) DeleteByValue(value V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.DeleteByValue(value)
+}
+
+func (s *SafeBiMap[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Len()
+}
+
+func (s *SafeBiMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+func (s *SafeBiMap[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+// Inverse returns a view of the map with keys and values swapped sharing the same lock.
+func (s *SafeBiMap[K, V]) Inverse() *SafeBiMap[V, K] {
+	return &SafeBiMap[V, K]{mu: s.mu, m: s.m.Inverse()}
+}
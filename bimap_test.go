@@ -0,0 +1,142 @@
+package collection_test
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestBiMapPut(t *testing.T) {
+	m := collection.NewBiMap[int, string]()
+
+	cases := []struct {
+		name  string
+		key   int
+		value string
+		want  error
+	}{
+		{"new entry", 1, "one", nil},
+		{"same entry", 1, "one", nil},
+		{"key bound", 1, "uno", collection.ErrBiMapConflict},
+		{"value bound", 2, "one", collection.ErrBiMapConflict},
+		{"second entry", 2, "two", nil},
+	}
+
+	for _, tc := range cases {
+		if err := m.Put(tc.key, tc.value); !errors.Is(err, tc.want) {
+			t.Errorf("%s: Put(%v, %v) = %v; want %v", tc.name, tc.key, tc.value, err, tc.want)
+		}
+	}
+
+	if want := map[int]string{1: "one", 2: "two"}; !maps.Equal(m.ToMap(), want) {
+		t.Errorf("ToMap() = %v; want %v", m.ToMap(), want)
+	}
+
+	m.ForcePut(1, "two")
+
+	if want := map[int]string{1: "two"}; !maps.Equal(m.ToMap(), want) {
+		t.Errorf("ForcePut(1, two) = %v; want %v", m.ToMap(), want)
+	}
+
+	if key, ok := m.GetByValue("two"); !ok || key != 1 {
+		t.Errorf("GetByValue(two) = (%v, %v); want (1, true)", key, ok)
+	}
+
+	if _, ok := m.GetByValue("one"); ok {
+		t.Errorf("GetByValue(one) after ForcePut ok = true; want false")
+	}
+}
+
+func TestBiMapDeleteAndInverse(t *testing.T) {
+	m, err := collection.SliceToBiMap([]string{"a", "bb", "ccc"},
+		func(s string) int { return len(s) },
+		func(s string) string { return s },
+	)
+	if err != nil {
+		t.Fatalf("SliceToBiMap() = %v; want nil", err)
+	}
+
+	inverse := m.Inverse()
+	if key, ok := inverse.GetByKey("bb"); !ok || key != 2 {
+		t.Errorf("Inverse().GetByKey(bb) = (%v, %v); want (2, true)", key, ok)
+	}
+
+	if !m.DeleteByKey(1) || m.DeleteByKey(1) || inverse.ContainsKey("a") {
+		t.Errorf("DeleteByKey(1) is not reflected in the inverse view")
+	}
+
+	if !inverse.DeleteByKey("ccc") || m.ContainsKey(3) || m.ContainsValue("ccc") {
+		t.Errorf("Inverse().DeleteByKey(ccc) is not reflected in the map")
+	}
+
+	if !m.DeleteByValue("bb") || m.Len() != 0 {
+		t.Errorf("DeleteByValue(bb) left Len() = %v; want 0", m.Len())
+	}
+
+	if _, err := collection.SliceToBiMap([]string{"a", "b"},
+		func(s string) int { return len(s) },
+		func(s string) string { return s },
+	); !errors.Is(err, collection.ErrBiMapConflict) {
+		t.Errorf("SliceToBiMap() with duplicate keys = %v; want %v", err, collection.ErrBiMapConflict)
+	}
+}
+
+func TestBiMapKeysValues(t *testing.T) {
+	m := collection.NewBiMap[int, string]()
+	_ = m.Put(1, "one")
+	_ = m.Put(2, "two")
+
+	keys, values := m.Keys(), m.Values()
+	sort.Ints(keys)
+	sort.Strings(values)
+
+	if !slices.Equal(keys, []int{1, 2}) || !slices.Equal(values, []string{"one", "two"}) {
+		t.Errorf("Keys(), Values() = %v, %v", keys, values)
+	}
+}
+
+func TestSafeBiMap(t *testing.T) {
+	m := collection.NewSafeBiMap[int, string]()
+	inverse := m.Inverse()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			_ = m.Put(i, fmt.Sprint(i))
+			m.GetByKey(i)
+			inverse.GetByKey(fmt.Sprint(i))
+		}(i)
+	}
+
+	wg.Wait()
+
+	if m.Len() != 50 || inverse.Len() != 50 {
+		t.Errorf("Len() = %v, Inverse().Len() = %v; want 50, 50", m.Len(), inverse.Len())
+	}
+
+	if err := m.Put(100, "1"); !errors.Is(err, collection.ErrBiMapConflict) {
+		t.Errorf("Put(100, 1) = %v; want %v", err, collection.ErrBiMapConflict)
+	}
+
+	m.ForcePut(100, "1")
+	if key, ok := m.GetByValue("1"); !ok || key != 100 {
+		t.Errorf("GetByValue(1) = (%v, %v); want (100, true)", key, ok)
+	}
+
+	if !inverse.DeleteByValue(100) || m.DeleteByKey(100) || !m.DeleteByValue("2") {
+		t.Errorf("DeleteByValue()/DeleteByKey() mismatch")
+	}
+
+	if len(m.Keys()) != 48 || len(m.Values()) != 48 {
+		t.Errorf("Keys(), Values() lengths = %v, %v; want 48, 48", len(m.Keys()), len(m.Values()))
+	}
+}
@@ -0,0 +1,230 @@
+package collection
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BlockingQueue is a concurrency-safe FIFO queue whose producers wait while it is full
+// and whose consumers wait while it is empty.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	items    Deque[T]
+	capacity int
+	notEmpty chan struct{}
+	notFull  chan struct{}
+	closed   bool
+}
+
+// NewBlockingQueue creates an empty BlockingQueue holding up to capacity elements.
+// A non-positive capacity makes the queue unbounded.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &BlockingQueue[T]{
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Put adds the value to the back of the queue, waiting for room until the context is done.
+// It returns ErrQueueClosed after Close.
+func (q *BlockingQueue[T]) Put(ctx context.Context, v T) error {
+	for {
+		q.mu.Lock()
+
+		if q.closed {
+			q.mu.Unlock()
+			return ErrQueueClosed
+		}
+
+		if q.capacity == 0 || q.items.Len() < q.capacity {
+			q.items.PushBack(v)
+			q.notEmpty = broadcast(q.notEmpty)
+			q.mu.Unlock()
+
+			return nil
+		}
+
+		var notFull = q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-notFull:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Offer adds the value to the back of the queue, waiting for room up to the timeout.
+// A non-positive timeout does not wait. It reports whether the value was added.
+func (q *BlockingQueue[T]) Offer(v T, timeout time.Duration) bool {
+	var ctx, cancel = timeoutContext(timeout)
+	defer cancel()
+
+	return q.Put(ctx, v) == nil
+}
+
+// Take removes and returns the front element, waiting for one until the context is done.
+// Once the queue is closed the remaining elements are still returned, then Take returns ErrQueueClosed.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+
+		if v, ok := q.items.PopFront(); ok {
+			q.notFull = broadcast(q.notFull)
+			q.mu.Unlock()
+
+			return v, nil
+		}
+
+		var (
+			closed   = q.closed
+			notEmpty = q.notEmpty
+			zero     T
+		)
+
+		q.mu.Unlock()
+
+		if closed {
+			return zero, ErrQueueClosed
+		}
+
+		select {
+		case <-notEmpty:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Poll removes and returns the front element, waiting for one up to the timeout.
+// A non-positive timeout does not wait. The ok result is false if no element was available.
+func (q *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	var ctx, cancel = timeoutContext(timeout)
+	defer cancel()
+
+	var v, err = q.Take(ctx)
+	return v, err == nil
+}
+
+// Peek returns the front element without removing it.
+// The ok result is false for an empty queue.
+func (q *BlockingQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Front()
+}
+
+// Len returns the number of elements in the queue.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Len()
+}
+
+// DrainTo removes and returns up to n elements from the front of the queue without waiting.
+// A non-positive n removes all elements.
+func (q *BlockingQueue[T]) DrainTo(n int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if n <= 0 || n > q.items.Len() {
+		n = q.items.Len()
+	}
+
+	var result = make([]T, n)
+	for i := range result {
+		result[i], _ = q.items.PopFront()
+	}
+
+	if n > 0 {
+		q.notFull = broadcast(q.notFull)
+	}
+
+	return result
+}
+
+// Close closes the queue for new elements. Consumers can still take the remaining elements.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.close()
+}
+
+// CloseAndDrain closes the queue and removes and returns the remaining elements.
+func (q *BlockingQueue[T]) CloseAndDrain() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.close()
+
+	var result = q.items.ToSlice()
+	q.items.Clear()
+
+	return result
+}
+
+// Chan returns a receive only channel delivering the elements taken from the queue.
+// The channel is closed once the queue is closed and drained or the context is done;
+// an element taken but not yet received when the context is done is put back at the front of the queue.
+func (q *BlockingQueue[T]) Chan(ctx context.Context) <-chan T {
+	var result = make(chan T)
+
+	go func() {
+		defer close(result)
+
+		for {
+			var v, err = q.Take(ctx)
+			if err != nil {
+				return
+			}
+
+			select {
+			case result <- v:
+			case <-ctx.Done():
+				q.mu.Lock()
+				q.items.PushFront(v)
+				q.notEmpty = broadcast(q.notEmpty)
+				q.mu.Unlock()
+
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+func (q *BlockingQueue[T]) close() {
+	if !q.closed {
+		q.closed = true
+		q.notEmpty = broadcast(q.notEmpty)
+		q.notFull = broadcast(q.notFull)
+	}
+}
+
+// broadcast wakes up every goroutine waiting on the signal channel and returns a new one.
+func broadcast(signal chan struct{}) chan struct{} {
+	close(signal)
+	return make(chan struct{})
+}
+
+func timeoutContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		var ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+
+		return ctx, cancel
+	}
+
+	return context.WithTimeout(context.Background(), timeout)
+}
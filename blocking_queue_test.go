@@ -0,0 +1,194 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestBlockingQueueBounded(t *testing.T) {
+	ctx := context.Background()
+	q := collection.NewBlockingQueue[int](2)
+
+	if !q.Offer(1, 0) || !q.Offer(2, 0) {
+		t.Fatalf("Offer() on queue with room = false; want true")
+	}
+
+	if q.Offer(3, 0) || q.Offer(3, 10*time.Millisecond) {
+		t.Errorf("Offer() on full queue = true; want false")
+	}
+
+	put := make(chan error)
+	go func() {
+		put <- q.Put(ctx, 3)
+	}()
+
+	expectIdle(t, put)
+
+	if v, err := q.Take(ctx); v != 1 || err != nil {
+		t.Errorf("Take() = (%v, %v); want (1, nil)", v, err)
+	}
+
+	if err := receive(t, put); err != nil {
+		t.Errorf("Put() = %v; want nil", err)
+	}
+
+	if v, ok := q.Peek(); !ok || v != 2 || q.Len() != 2 {
+		t.Errorf("Peek() = (%v, %v), Len() = %v; want (2, true), 2", v, ok, q.Len())
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := q.Put(timeout, 4); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Put() on full queue = %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestBlockingQueueTake(t *testing.T) {
+	q := collection.NewBlockingQueue[int](0)
+
+	if _, ok := q.Poll(0); ok {
+		t.Errorf("Poll() on empty queue ok = true; want false")
+	}
+
+	taken := make(chan int)
+	go func() {
+		v, _ := q.Take(context.Background())
+		taken <- v
+	}()
+
+	expectIdle(t, taken)
+
+	for i := 1; i <= 100; i++ {
+		if err := q.Put(context.Background(), i); err != nil {
+			t.Fatalf("Put() on unbounded queue = %v; want nil", err)
+		}
+	}
+
+	if got := receive(t, taken); got != 1 {
+		t.Errorf("Take() = %v; want 1", got)
+	}
+
+	if v, ok := q.Poll(time.Millisecond); !ok || v != 2 {
+		t.Errorf("Poll() = (%v, %v); want (2, true)", v, ok)
+	}
+
+	if got := q.DrainTo(3); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("DrainTo(3) = %v; want %v", got, []int{3, 4, 5})
+	}
+
+	if got := q.DrainTo(0); len(got) != 95 || q.Len() != 0 {
+		t.Errorf("DrainTo(0) = %d elements, Len() = %v; want 95, 0", len(got), q.Len())
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	ctx := context.Background()
+	q := collection.NewBlockingQueue[int](1)
+
+	_ = q.Put(ctx, 1)
+
+	blocked := make(chan error)
+	go func() {
+		blocked <- q.Put(ctx, 2)
+	}()
+
+	expectIdle(t, blocked)
+	q.Close()
+
+	if err := receive(t, blocked); !errors.Is(err, collection.ErrQueueClosed) {
+		t.Errorf("blocked Put() after Close = %v; want %v", err, collection.ErrQueueClosed)
+	}
+
+	if v, err := q.Take(ctx); v != 1 || err != nil {
+		t.Errorf("Take() after Close = (%v, %v); want (1, nil)", v, err)
+	}
+
+	if _, err := q.Take(ctx); !errors.Is(err, collection.ErrQueueClosed) {
+		t.Errorf("Take() on drained closed queue = %v; want %v", err, collection.ErrQueueClosed)
+	}
+}
+
+func TestBlockingQueueCloseAndDrain(t *testing.T) {
+	q := collection.NewBlockingQueue[int](0)
+	for i := 1; i <= 3; i++ {
+		q.Offer(i, 0)
+	}
+
+	if got := q.CloseAndDrain(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("CloseAndDrain() = %v; want %v", got, []int{1, 2, 3})
+	}
+
+	if q.Offer(4, 0) {
+		t.Errorf("Offer() after CloseAndDrain = true; want false")
+	}
+}
+
+func TestBlockingQueueChan(t *testing.T) {
+	ctx := context.Background()
+
+	first := collection.NewBlockingQueue[int](0)
+	second := collection.NewBlockingQueue[int](0)
+
+	for i := 1; i <= 3; i++ {
+		_ = first.Put(ctx, i)
+		_ = second.Put(ctx, i*10)
+	}
+
+	first.Close()
+	second.Close()
+
+	var sum int
+	for v := range collection.ChannelsMerge(first.Chan(ctx), second.Chan(ctx)) {
+		sum += v
+	}
+
+	if sum != 66 {
+		t.Errorf("ChannelsMerge(Chan()) sum = %v; want 66", sum)
+	}
+}
+
+func TestBlockingQueueChanCanceled(t *testing.T) {
+	q := collection.NewBlockingQueue[int](0)
+	_ = q.Put(context.Background(), 1)
+	_ = q.Put(context.Background(), 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := q.Chan(ctx)
+
+	deadline := time.Now().Add(time.Second)
+	for q.Len() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Chan() did not take an element")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+
+	for q.Len() != 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Chan() did not put the pending element back, Len() = %v", q.Len())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if got := drain(ch); len(got) != 0 {
+		t.Errorf("Chan() after cancel = %v; want no elements", got)
+	}
+
+	if v, ok := q.Peek(); !ok || v != 1 {
+		t.Errorf("Peek() = (%v, %v); want (1, true)", v, ok)
+	}
+
+	for _, want := range []int{1, 2} {
+		if v, err := q.Take(context.Background()); err != nil || v != want {
+			t.Errorf("Take() = (%v, %v); want (%v, nil)", v, err, want)
+		}
+	}
+}
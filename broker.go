@@ -0,0 +1,291 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrBrokerClosed is returned by the Broker operations called after Close.
+var ErrBrokerClosed = errors.New("collection: broker closed")
+
+// OverflowPolicy defines what a Broker does when a subscriber buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock makes the publisher wait until the subscriber has room.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest discards the value being published.
+	OverflowDropNewest
+	// OverflowDropOldest discards the oldest buffered value to make room for the new one.
+	// Without a buffer it behaves as OverflowDropNewest.
+	OverflowDropOldest
+)
+
+// SubscribeOptions configures a Broker subscription.
+type SubscribeOptions[T any] struct {
+	// Buffer is the capacity of the subscription channel.
+	Buffer int
+	// Overflow is the policy applied when the buffer is full.
+	Overflow OverflowPolicy
+	// Filter, if set, receives only the values it accepts.
+	Filter Filter[T]
+}
+
+// BrokerMetrics is a snapshot of the delivery counters of a Broker.
+type BrokerMetrics struct {
+	Published   uint64
+	Delivered   uint64
+	Dropped     uint64
+	Subscribers int
+}
+
+// Broker is an in-process publish/subscribe hub delivering values of type T by topic.
+type Broker[T any] struct {
+	mu        sync.RWMutex
+	subs      map[*Subscription[T]]struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+
+	published atomic.Uint64
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+// NewBroker creates a new Broker.
+func NewBroker[T any]() *Broker[T] {
+	return &Broker[T]{
+		subs: make(map[*Subscription[T]]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Subscription is a registration of a subscriber in a Broker.
+type Subscription[T any] struct {
+	broker   *Broker[T]
+	pattern  string
+	options  SubscribeOptions[T]
+	ch       chan T
+	done     chan struct{}
+	stopOnce sync.Once
+
+	// sending is held for reading while a value is sent on ch and for writing while ch is closed.
+	sending sync.RWMutex
+	closed  bool
+	// evicting serialises OverflowDropOldest deliveries so an evicted value is always counted as delivered first.
+	evicting sync.Mutex
+
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+// Subscribe registers a subscriber for the topics matching the pattern.
+// The pattern uses the path.Match syntax, so "*" matches any topic without a '/'.
+// It returns path.ErrBadPattern for a malformed pattern and ErrBrokerClosed after Close.
+func (b *Broker[T]) Subscribe(pattern string, options SubscribeOptions[T]) (*Subscription[T], error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	if options.Buffer < 0 {
+		options.Buffer = 0
+	}
+
+	var s = &Subscription[T]{
+		broker:  b,
+		pattern: pattern,
+		options: options,
+		ch:      make(chan T, options.Buffer),
+		done:    make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs == nil {
+		return nil, ErrBrokerClosed
+	}
+
+	b.subs[s] = struct{}{}
+
+	return s, nil
+}
+
+// Publish delivers the value to every subscriber of the topic.
+// Subscribers with the OverflowBlock policy may make Publish wait; the wait ends when the context is done,
+// the subscriber unsubscribes or the broker is closed.
+func (b *Broker[T]) Publish(ctx context.Context, topic string, value T) error {
+	b.mu.RLock()
+	if b.subs == nil {
+		b.mu.RUnlock()
+		return ErrBrokerClosed
+	}
+
+	b.published.Add(1)
+
+	var subs = make([]*Subscription[T], 0, len(b.subs))
+	for s := range b.subs {
+		subs = append(subs, s)
+	}
+	b.mu.RUnlock()
+
+	for _, s := range subs {
+		if !s.accepts(topic, value) {
+			continue
+		}
+
+		if err := b.deliver(ctx, s, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *Broker[T]) deliver(ctx context.Context, s *Subscription[T], value T) error {
+	s.sending.RLock()
+	defer s.sending.RUnlock()
+
+	if s.closed {
+		return nil
+	}
+
+	switch s.options.Overflow {
+	case OverflowDropNewest:
+		select {
+		case s.ch <- value:
+			s.delivered.Add(1)
+			b.delivered.Add(1)
+		default:
+			s.dropped.Add(1)
+			b.dropped.Add(1)
+		}
+	case OverflowDropOldest:
+		s.evicting.Lock()
+		defer s.evicting.Unlock()
+
+		for {
+			select {
+			case s.ch <- value:
+				s.delivered.Add(1)
+				b.delivered.Add(1)
+				return nil
+			default:
+			}
+
+			if cap(s.ch) == 0 {
+				s.dropped.Add(1)
+				b.dropped.Add(1)
+				return nil
+			}
+
+			select {
+			case <-s.ch:
+				s.delivered.Add(^uint64(0))
+				b.delivered.Add(^uint64(0))
+				s.dropped.Add(1)
+				b.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- value:
+			s.delivered.Add(1)
+			b.delivered.Add(1)
+		case <-s.done:
+		case <-b.done:
+			return ErrBrokerClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Close closes every subscription channel and unblocks pending publishers.
+func (b *Broker[T]) Close() {
+	b.closeOnce.Do(func() {
+		close(b.done)
+
+		b.mu.Lock()
+		var subs = b.subs
+		b.subs = nil
+		b.mu.Unlock()
+
+		for s := range subs {
+			s.close()
+		}
+	})
+}
+
+// Metrics returns a snapshot of the broker delivery counters.
+func (b *Broker[T]) Metrics() BrokerMetrics {
+	b.mu.RLock()
+	var subscribers = len(b.subs)
+	b.mu.RUnlock()
+
+	return BrokerMetrics{
+		Published:   b.published.Load(),
+		Delivered:   b.delivered.Load(),
+		Dropped:     b.dropped.Load(),
+		Subscribers: subscribers,
+	}
+}
+
+// C returns the channel on which the subscribed values are delivered.
+// The channel is closed on Unsubscribe or when the broker is closed.
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+// Unsubscribe removes the subscription from the broker and closes its channel.
+func (s *Subscription[T]) Unsubscribe() {
+	var b = s.broker
+
+	b.mu.Lock()
+	var _, ok = b.subs[s]
+	delete(b.subs, s)
+	b.mu.Unlock()
+
+	if ok {
+		s.close()
+	}
+}
+
+// Delivered returns the number of values delivered to the subscription.
+// Values later evicted by the OverflowDropOldest policy are counted as dropped instead.
+func (s *Subscription[T]) Delivered() uint64 {
+	return s.delivered.Load()
+}
+
+// Dropped returns the number of values dropped by the overflow policy of the subscription.
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// close unblocks pending publishers and closes the channel once no value is being sent on it.
+func (s *Subscription[T]) close() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+	})
+
+	s.sending.Lock()
+	defer s.sending.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
+
+func (s *Subscription[T]) accepts(topic string, value T) bool {
+	if ok, _ := path.Match(s.pattern, topic); !ok {
+		return false
+	}
+
+	return s.options.Filter == nil || s.options.Filter(value)
+}
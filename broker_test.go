@@ -0,0 +1,211 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"path"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func drain[T any](ch <-chan T) []T {
+	var result []T
+	for v := range ch {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func TestBrokerTopics(t *testing.T) {
+	ctx := context.Background()
+	broker := collection.NewBroker[int]()
+
+	orders, _ := broker.Subscribe("orders.*", collection.SubscribeOptions[int]{Buffer: 10})
+	created, _ := broker.Subscribe("orders.created", collection.SubscribeOptions[int]{Buffer: 10})
+	even, _ := broker.Subscribe("*", collection.SubscribeOptions[int]{Buffer: 10, Filter: func(v int) bool { return v%2 == 0 }})
+
+	for i, topic := range []string{"orders.created", "orders.paid", "users.created", "orders.created"} {
+		if err := broker.Publish(ctx, topic, i); err != nil {
+			t.Fatalf("Publish(%v) = %v; want nil", topic, err)
+		}
+	}
+
+	broker.Close()
+
+	cases := []struct {
+		name string
+		sub  *collection.Subscription[int]
+		want []int
+	}{
+		{"wildcard", orders, []int{0, 1, 3}},
+		{"exact", created, []int{0, 3}},
+		{"predicate", even, []int{0, 2}},
+	}
+
+	for _, tc := range cases {
+		if got := drain(tc.sub.C()); !slices.Equal(got, tc.want) {
+			t.Errorf("Subscription %s = %v; want %v", tc.name, got, tc.want)
+		}
+	}
+
+	want := collection.BrokerMetrics{Published: 4, Delivered: 7}
+	if got := broker.Metrics(); got != want {
+		t.Errorf("Metrics() = %+v; want %+v", got, want)
+	}
+}
+
+func TestBrokerOverflow(t *testing.T) {
+	cases := []struct {
+		name          string
+		overflow      collection.OverflowPolicy
+		want          []int
+		wantDelivered uint64
+	}{
+		{"drop newest", collection.OverflowDropNewest, []int{1, 2}, 2},
+		{"drop oldest", collection.OverflowDropOldest, []int{4, 5}, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			broker := collection.NewBroker[int]()
+			sub, _ := broker.Subscribe("t", collection.SubscribeOptions[int]{Buffer: 2, Overflow: tc.overflow})
+
+			for i := 1; i <= 5; i++ {
+				_ = broker.Publish(context.Background(), "t", i)
+			}
+
+			broker.Close()
+
+			if got := drain(sub.C()); !slices.Equal(got, tc.want) {
+				t.Errorf("Subscription = %v; want %v", got, tc.want)
+			}
+
+			if sub.Delivered() != tc.wantDelivered || sub.Dropped() != 3 {
+				t.Errorf("Delivered() = %v, Dropped() = %v; want %v, 3", sub.Delivered(), sub.Dropped(), tc.wantDelivered)
+			}
+
+			want := collection.BrokerMetrics{Published: 5, Delivered: tc.wantDelivered, Dropped: 3}
+			if got := broker.Metrics(); got != want {
+				t.Errorf("Metrics() = %+v; want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerBlockingPublish(t *testing.T) {
+	broker := collection.NewBroker[int]()
+	_, _ = broker.Subscribe("t", collection.SubscribeOptions[int]{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := broker.Publish(ctx, "t", 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Publish() = %v; want %v", err, context.DeadlineExceeded)
+	}
+
+	published := make(chan error)
+	go func() {
+		published <- broker.Publish(context.Background(), "t", 2)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	broker.Close()
+
+	if err := <-published; !errors.Is(err, collection.ErrBrokerClosed) {
+		t.Errorf("Publish() = %v; want %v", err, collection.ErrBrokerClosed)
+	}
+
+	if _, err := broker.Subscribe("t", collection.SubscribeOptions[int]{}); !errors.Is(err, collection.ErrBrokerClosed) {
+		t.Errorf("Subscribe() = %v; want %v", err, collection.ErrBrokerClosed)
+	}
+}
+
+func TestBrokerBlockedPublishDoesNotBlockBroker(t *testing.T) {
+	broker := collection.NewBroker[int]()
+	defer broker.Close()
+
+	slow, _ := broker.Subscribe("t", collection.SubscribeOptions[int]{})
+
+	published := make(chan error)
+	go func() {
+		published <- broker.Publish(context.Background(), "t", 1)
+	}()
+
+	for broker.Metrics().Published == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	subscribed := make(chan error)
+	go func() {
+		_, err := broker.Subscribe("other", collection.SubscribeOptions[int]{})
+		subscribed <- err
+	}()
+
+	metrics := make(chan collection.BrokerMetrics)
+	go func() {
+		metrics <- broker.Metrics()
+	}()
+
+	select {
+	case err := <-subscribed:
+		if err != nil {
+			t.Errorf("Subscribe() = %v; want nil", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Subscribe() blocked by a publisher waiting on a slow subscriber")
+	}
+
+	select {
+	case <-metrics:
+	case <-time.After(time.Second):
+		t.Fatalf("Metrics() blocked by a publisher waiting on a slow subscriber")
+	}
+
+	if got := receive(t, slow.C()); got != 1 {
+		t.Errorf("Subscription = %v; want 1", got)
+	}
+
+	if err := <-published; err != nil {
+		t.Errorf("Publish() = %v; want nil", err)
+	}
+}
+
+func TestBrokerUnsubscribe(t *testing.T) {
+	broker := collection.NewBroker[int]()
+	defer broker.Close()
+
+	sub, _ := broker.Subscribe("t", collection.SubscribeOptions[int]{})
+
+	published := make(chan error)
+	go func() {
+		published <- broker.Publish(context.Background(), "t", 1)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	sub.Unsubscribe()
+
+	if err := <-published; err != nil {
+		t.Errorf("Publish() = %v; want nil", err)
+	}
+
+	if _, ok := <-sub.C(); ok {
+		t.Errorf("Unsubscribe: channel not closed")
+	}
+
+	if got := broker.Metrics().Subscribers; got != 0 {
+		t.Errorf("Metrics().Subscribers = %v; want 0", got)
+	}
+}
+
+func TestBrokerBadPattern(t *testing.T) {
+	broker := collection.NewBroker[int]()
+	defer broker.Close()
+
+	if _, err := broker.Subscribe("[", collection.SubscribeOptions[int]{}); !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("Subscribe() = %v; want %v", err, path.ErrBadPattern)
+	}
+}
@@ -1,6 +1,33 @@
 package collection
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ChannelOption configures the time-based channel operators.
+type ChannelOption func(*channelOptions)
+
+type channelOptions struct {
+	clock Clock
+}
+
+// WithClock sets the Clock used by the time-based channel operators. Defaults to SystemClock.
+func WithClock(clock Clock) ChannelOption {
+	return func(o *channelOptions) {
+		o.clock = clock
+	}
+}
+
+func newChannelOptions(opts []ChannelOption) channelOptions {
+	var o = channelOptions{clock: SystemClock()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
 
 // ChannelsReadonly transforms input N channels to receive only channels
 func ChannelsReadonly[T any](args ...chan T) []<-chan T {
@@ -32,3 +59,176 @@ func ChannelsMerge[T any](args ...<-chan T) <-chan T {
 
 	return result
 }
+
+// ChannelThrottle limits the rate of the source channel to n values per interval using a token bucket.
+// The bucket holds up to n tokens and is refilled with one token every interval/n,
+// or with several tokens at once when interval/n is shorter than a nanosecond.
+// If n or interval is not positive the source channel is returned as is.
+func ChannelThrottle[T any](source <-chan T, n int, interval time.Duration, opts ...ChannelOption) <-chan T {
+	if n <= 0 || interval <= 0 {
+		return source
+	}
+
+	var (
+		o      = newChannelOptions(opts)
+		result = make(chan T)
+	)
+
+	go func() {
+		defer close(result)
+
+		var period, refill = interval / time.Duration(n), 1
+		if period <= 0 {
+			refill = (n + int(interval) - 1) / int(interval)
+			period = interval * time.Duration(refill) / time.Duration(n)
+		}
+
+		var ticker = o.clock.NewTicker(period)
+		defer ticker.Stop()
+
+		var tokens = n
+		for {
+			var in = source
+			if tokens == 0 {
+				in = nil
+			}
+
+			select {
+			case <-ticker.C():
+				tokens = Min(tokens+refill, n)
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+
+				result <- v
+				tokens--
+			}
+		}
+	}()
+
+	return result
+}
+
+// ChannelDebounce emits the last value received from the source channel once no new value
+// has arrived for the quiet period. A pending value is emitted when the source channel is closed.
+func ChannelDebounce[T any](source <-chan T, quiet time.Duration, opts ...ChannelOption) <-chan T {
+	var (
+		o      = newChannelOptions(opts)
+		result = make(chan T)
+	)
+
+	go func() {
+		defer close(result)
+
+		var (
+			timer   Timer
+			fire    <-chan time.Time
+			pending T
+		)
+
+		for {
+			select {
+			case v, ok := <-source:
+				if !ok {
+					if timer != nil {
+						timer.Stop()
+						result <- pending
+					}
+
+					return
+				}
+
+				if timer != nil {
+					timer.Stop()
+				}
+
+				pending = v
+				timer = o.clock.NewTimer(quiet)
+				fire = timer.C()
+			case <-fire:
+				result <- pending
+
+				timer, fire = nil, nil
+			}
+		}
+	}()
+
+	return result
+}
+
+// ChannelSample emits the latest value received from the source channel on every tick of the interval.
+// Ticks without a new value emit nothing. A pending value is emitted when the source channel is closed.
+// If interval is not positive the source channel is returned as is.
+func ChannelSample[T any](source <-chan T, interval time.Duration, opts ...ChannelOption) <-chan T {
+	if interval <= 0 {
+		return source
+	}
+
+	var (
+		o      = newChannelOptions(opts)
+		result = make(chan T)
+	)
+
+	go func() {
+		defer close(result)
+
+		var ticker = o.clock.NewTicker(interval)
+		defer ticker.Stop()
+
+		var (
+			latest T
+			fresh  bool
+		)
+
+		for {
+			select {
+			case v, ok := <-source:
+				if !ok {
+					if fresh {
+						result <- latest
+					}
+
+					return
+				}
+
+				latest, fresh = v, true
+			case <-ticker.C():
+				if fresh {
+					result <- latest
+					fresh = false
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// ChannelDropping forwards values from the source channel to a channel with the given buffer size,
+// dropping values whenever the buffer is full because the consumer is slow.
+// The returned function reports the number of dropped values.
+func ChannelDropping[T any](source <-chan T, buffer int) (<-chan T, func() uint64) {
+	if buffer < 0 {
+		buffer = 0
+	}
+
+	var (
+		result  = make(chan T, buffer)
+		dropped atomic.Uint64
+	)
+
+	go func() {
+		defer close(result)
+
+		for v := range source {
+			select {
+			case result <- v:
+			default:
+				dropped.Add(1)
+			}
+		}
+	}()
+
+	return result, dropped.Load
+}
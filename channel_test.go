@@ -1,7 +1,9 @@
 package collection_test
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/sergeydobrodey/collection"
 )
@@ -28,3 +30,198 @@ func TestChannelsMerge(t *testing.T) {
 		t.Errorf("ChannelsMerge = %v; want %v", sum, expected)
 	}
 }
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatalf("receive: timed out")
+	}
+
+	panic("unreachable")
+}
+
+func expectIdle[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+
+	select {
+	case v, ok := <-ch:
+		t.Fatalf("expectIdle: got (%v, %v); want no value", v, ok)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestChannelThrottle(t *testing.T) {
+	clock := newFakeClock()
+
+	source := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		source <- i
+	}
+	close(source)
+
+	throttled := collection.ChannelThrottle(source, 2, time.Second, collection.WithClock(clock))
+
+	for _, want := range []int{1, 2} {
+		if got := receive(t, throttled); got != want {
+			t.Fatalf("ChannelThrottle = %v; want %v", got, want)
+		}
+	}
+
+	expectIdle(t, throttled)
+
+	clock.WaitCreated(1)
+	for _, want := range []int{3, 4, 5} {
+		clock.Advance(500 * time.Millisecond)
+
+		if got := receive(t, throttled); got != want {
+			t.Fatalf("ChannelThrottle = %v; want %v", got, want)
+		}
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if _, ok := <-throttled; ok {
+		t.Errorf("ChannelThrottle: channel not closed")
+	}
+}
+
+func TestChannelThrottleSubNanosecondPeriod(t *testing.T) {
+	clock := newFakeClock()
+
+	source := make(chan int, 6)
+	for i := 1; i <= 6; i++ {
+		source <- i
+	}
+	close(source)
+
+	throttled := collection.ChannelThrottle(source, 4, 2*time.Nanosecond, collection.WithClock(clock))
+
+	for _, want := range []int{1, 2, 3, 4} {
+		if got := receive(t, throttled); got != want {
+			t.Fatalf("ChannelThrottle = %v; want %v", got, want)
+		}
+	}
+
+	expectIdle(t, throttled)
+
+	clock.WaitCreated(1)
+	clock.Advance(time.Nanosecond)
+	for _, want := range []int{5, 6} {
+		if got := receive(t, throttled); got != want {
+			t.Fatalf("ChannelThrottle = %v; want %v", got, want)
+		}
+	}
+
+	clock.Advance(time.Nanosecond)
+	if _, ok := <-throttled; ok {
+		t.Errorf("ChannelThrottle: channel not closed")
+	}
+
+	source = make(chan int, 3)
+	for i := 1; i <= 3; i++ {
+		source <- i
+	}
+	close(source)
+
+	var got []int
+	for v := range collection.ChannelThrottle(source, 1000, 500*time.Nanosecond) {
+		got = append(got, v)
+	}
+
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("ChannelThrottle(1000, 500ns) = %v; want [1 2 3]", got)
+	}
+}
+
+func TestChannelThrottleUnlimited(t *testing.T) {
+	source := make(chan int)
+
+	if got := collection.ChannelThrottle(source, 0, time.Second); got != (<-chan int)(source) {
+		t.Errorf("ChannelThrottle(n = 0) did not return the source channel")
+	}
+}
+
+func TestChannelDebounce(t *testing.T) {
+	clock := newFakeClock()
+
+	source := make(chan int)
+	debounced := collection.ChannelDebounce(source, time.Second, collection.WithClock(clock))
+
+	source <- 1
+	source <- 2
+	clock.WaitCreated(2)
+
+	expectIdle(t, debounced)
+
+	clock.Advance(time.Second)
+	if got := receive(t, debounced); got != 2 {
+		t.Fatalf("ChannelDebounce = %v; want %v", got, 2)
+	}
+
+	source <- 3
+	close(source)
+
+	if got := receive(t, debounced); got != 3 {
+		t.Fatalf("ChannelDebounce = %v; want %v", got, 3)
+	}
+
+	if _, ok := <-debounced; ok {
+		t.Errorf("ChannelDebounce: channel not closed")
+	}
+}
+
+func TestChannelSample(t *testing.T) {
+	clock := newFakeClock()
+
+	source := make(chan int)
+	sampled := collection.ChannelSample(source, time.Second, collection.WithClock(clock))
+
+	source <- 1
+	source <- 2
+	clock.WaitCreated(1)
+
+	clock.Advance(time.Second)
+	if got := receive(t, sampled); got != 2 {
+		t.Fatalf("ChannelSample = %v; want %v", got, 2)
+	}
+
+	clock.Advance(time.Second)
+	expectIdle(t, sampled)
+
+	source <- 3
+	close(source)
+
+	if got := receive(t, sampled); got != 3 {
+		t.Fatalf("ChannelSample = %v; want %v", got, 3)
+	}
+
+	if _, ok := <-sampled; ok {
+		t.Errorf("ChannelSample: channel not closed")
+	}
+}
+
+func TestChannelDropping(t *testing.T) {
+	source := make(chan int)
+	result, dropped := collection.ChannelDropping(source, 2)
+
+	for i := 1; i <= 5; i++ {
+		source <- i
+	}
+	close(source)
+
+	var got []int
+	for v := range result {
+		got = append(got, v)
+	}
+
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("ChannelDropping = %v; want %v", got, []int{1, 2})
+	}
+
+	if dropped() != 3 {
+		t.Errorf("ChannelDropping dropped = %v; want %v", dropped(), 3)
+	}
+}
@@ -0,0 +1,59 @@
+package collection
+
+import "time"
+
+// Clock abstracts the passage of time for the time-based operators of the package.
+// The default implementation is backed by the time package; tests can supply a fake one.
+type Clock interface {
+	// NewTimer creates a Timer that fires once after at least the duration d.
+	NewTimer(d time.Duration) Timer
+	// NewTicker creates a Ticker that fires every period d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event timer created by a Clock.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+	// Stop prevents the Timer from firing and reports whether the call stopped the timer.
+	Stop() bool
+}
+
+// Ticker is a periodic timer created by a Clock.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// SystemClock returns a Clock backed by the time package.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+type systemTicker struct {
+	*time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
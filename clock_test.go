@@ -0,0 +1,120 @@
+package collection_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+// fakeClock is a manually advanced collection.Clock used to test time-based operators deterministically.
+type fakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	created int
+	timers  []*fakeTimer
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Unix(0, 0)}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+type fakeTimer struct {
+	clock   *fakeClock
+	c       chan time.Time
+	next    time.Time
+	period  time.Duration
+	stopped bool
+}
+
+func (c *fakeClock) add(d time.Duration, period time.Duration) *fakeTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1), next: c.now.Add(d), period: period}
+	c.timers = append(c.timers, t)
+	c.created++
+	c.cond.Broadcast()
+	return t
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) collection.Timer {
+	return fakeTimerHandle{c.add(d, 0)}
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) collection.Ticker {
+	return fakeTickerHandle{c.add(d, d)}
+}
+
+// WaitCreated blocks until at least n timers or tickers have been created.
+func (c *fakeClock) WaitCreated(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.created < n {
+		c.cond.Wait()
+	}
+}
+
+// Advance moves the clock forward by d and fires every timer and ticker that became due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	for _, t := range c.timers {
+		for !t.stopped && !t.next.After(c.now) {
+			select {
+			case t.c <- t.next:
+			default:
+			}
+
+			if t.period == 0 {
+				t.stopped = true
+				break
+			}
+
+			t.next = t.next.Add(t.period)
+		}
+	}
+}
+
+func (t *fakeTimer) stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := !t.stopped
+	t.stopped = true
+	return active
+}
+
+type fakeTimerHandle struct{ *fakeTimer }
+
+func (t fakeTimerHandle) C() <-chan time.Time { return t.c }
+func (t fakeTimerHandle) Stop() bool          { return t.stop() }
+
+type fakeTickerHandle struct{ *fakeTimer }
+
+func (t fakeTickerHandle) C() <-chan time.Time { return t.c }
+func (t fakeTickerHandle) Stop()               { t.stop() }
+
+func TestSystemClock(t *testing.T) {
+	clock := collection.SystemClock()
+
+	timer := clock.NewTimer(time.Millisecond)
+	<-timer.C()
+
+	if timer.Stop() {
+		t.Errorf("Timer.Stop() after fire = true; want false")
+	}
+
+	ticker := clock.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+
+	<-ticker.C()
+	<-ticker.C()
+}
@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"cmp"
 	"slices"
 
 	"golang.org/x/exp/constraints"
@@ -78,3 +79,272 @@ func EqualFunc[S1 ~[]T1, S2 ~[]T2, T1, T2 any](s1 S1, s2 S2, eq func(T1, T2) boo
 func MapEqualFunc[M1 ~map[K]V1, M2 ~map[K]V2, K comparable, V1, V2 any](m1 M1, m2 M2, eq func(V1, V2) bool) bool {
 	return maps.EqualFunc(m1, m2, eq)
 }
+
+// Comparator is a three-way comparison function returning a negative number when l < r,
+// zero when l == r and a positive number when l > r. It can be passed to slices.SortFunc directly.
+type Comparator[T any] func(l T, r T) int
+
+// Natural returns a Comparator using the natural order of T.
+func Natural[T constraints.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// ByKey returns a Comparator ordering elements by the key returned by keyFunc.
+func ByKey[T any, K constraints.Ordered](keyFunc func(T) K) Comparator[T] {
+	return func(l, r T) int {
+		return cmp.Compare(keyFunc(l), keyFunc(r))
+	}
+}
+
+// ComparatorOf adapts a less function to a Comparator.
+func ComparatorOf[T any](less func(l T, r T) bool) Comparator[T] {
+	return func(l, r T) int {
+		switch {
+		case less(l, r):
+			return -1
+		case less(r, l):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// Chain returns a Comparator that orders elements by the first comparator that tells them apart.
+func Chain[T any](comparators ...Comparator[T]) Comparator[T] {
+	return func(l, r T) int {
+		for _, c := range comparators {
+			if result := c(l, r); result != 0 {
+				return result
+			}
+		}
+
+		return 0
+	}
+}
+
+// NilsFirst returns a Comparator of pointers that orders nil before any other pointer
+// and compares the pointed values with c.
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return func(l, r *T) int {
+		switch {
+		case l == nil && r == nil:
+			return 0
+		case l == nil:
+			return -1
+		case r == nil:
+			return 1
+		default:
+			return c(*l, *r)
+		}
+	}
+}
+
+// NilsLast returns a Comparator of pointers that orders nil after any other pointer
+// and compares the pointed values with c.
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	var nilsFirst = NilsFirst(c)
+
+	return func(l, r *T) int {
+		if (l == nil) != (r == nil) {
+			return -nilsFirst(l, r)
+		}
+
+		return nilsFirst(l, r)
+	}
+}
+
+// Reversed returns a Comparator with the opposite order.
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(l, r T) int {
+		return c(r, l)
+	}
+}
+
+// Less adapts the Comparator to a Less accepted by SortBy.
+func (c Comparator[T]) Less() Less[T] {
+	return func(l, r T) bool {
+		return c(l, r) < 0
+	}
+}
+
+// MinBy returns the first smallest element of the slice according to the comparator.
+// The ok result is false for an empty slice.
+func MinBy[S ~[]T, T any](source S, c Comparator[T]) (result T, ok bool) {
+	if len(source) == 0 {
+		return result, false
+	}
+
+	return slices.MinFunc(source, c), true
+}
+
+// MaxBy returns the first largest element of the slice according to the comparator.
+// The ok result is false for an empty slice.
+func MaxBy[S ~[]T, T any](source S, c Comparator[T]) (result T, ok bool) {
+	if len(source) == 0 {
+		return result, false
+	}
+
+	return slices.MaxFunc(source, c), true
+}
+
+// MinByKey returns the first element of the slice with the smallest key returned by the given key function.
+// The key function is called once per element. The ok result is false for an empty slice.
+func MinByKey[S ~[]T, T any, K constraints.Ordered](source S, keyFunc func(T) K) (result T, ok bool) {
+	if len(source) == 0 {
+		return result, false
+	}
+
+	var best = keyFunc(source[0])
+	result = source[0]
+	for _, v := range source[1:] {
+		if key := keyFunc(v); key < best {
+			best, result = key, v
+		}
+	}
+
+	return result, true
+}
+
+// MaxByKey returns the first element of the slice with the largest key returned by the given key function.
+// The key function is called once per element. The ok result is false for an empty slice.
+func MaxByKey[S ~[]T, T any, K constraints.Ordered](source S, keyFunc func(T) K) (result T, ok bool) {
+	if len(source) == 0 {
+		return result, false
+	}
+
+	var best = keyFunc(source[0])
+	result = source[0]
+	for _, v := range source[1:] {
+		if key := keyFunc(v); key > best {
+			best, result = key, v
+		}
+	}
+
+	return result, true
+}
+
+// ArgMin returns the index of the first smallest element of the slice. The ok result is false for an empty slice.
+func ArgMin[S ~[]T, T constraints.Ordered](source S) (int, bool) {
+	return ArgMinBy(source, cmp.Compare[T])
+}
+
+// ArgMinBy returns the index of the first smallest element of the slice according to the comparator.
+// The ok result is false for an empty slice.
+func ArgMinBy[S ~[]T, T any](source S, c Comparator[T]) (int, bool) {
+	if len(source) == 0 {
+		return -1, false
+	}
+
+	var result int
+	for i := 1; i < len(source); i++ {
+		if c(source[i], source[result]) < 0 {
+			result = i
+		}
+	}
+
+	return result, true
+}
+
+// ArgMax returns the index of the first largest element of the slice. The ok result is false for an empty slice.
+func ArgMax[S ~[]T, T constraints.Ordered](source S) (int, bool) {
+	return ArgMaxBy(source, cmp.Compare[T])
+}
+
+// ArgMaxBy returns the index of the first largest element of the slice according to the comparator.
+// The ok result is false for an empty slice.
+func ArgMaxBy[S ~[]T, T any](source S, c Comparator[T]) (int, bool) {
+	if len(source) == 0 {
+		return -1, false
+	}
+
+	var result int
+	for i := 1; i < len(source); i++ {
+		if c(source[i], source[result]) > 0 {
+			result = i
+		}
+	}
+
+	return result, true
+}
+
+// MinMax returns the first smallest and the first largest elements of the slice in a single pass.
+// The ok result is false for an empty slice.
+func MinMax[S ~[]T, T constraints.Ordered](source S) (min T, max T, ok bool) {
+	return MinMaxBy(source, cmp.Compare[T])
+}
+
+// MinMaxBy returns the first smallest and the first largest elements of the slice according to the comparator
+// in a single pass. The ok result is false for an empty slice.
+func MinMaxBy[S ~[]T, T any](source S, c Comparator[T]) (min T, max T, ok bool) {
+	if len(source) == 0 {
+		return min, max, false
+	}
+
+	min, max = source[0], source[0]
+	for _, v := range source[1:] {
+		if c(v, min) < 0 {
+			min = v
+		}
+
+		if c(v, max) > 0 {
+			max = v
+		}
+	}
+
+	return min, max, true
+}
+
+// MapMinBy returns the entry of the map with the smallest value according to the comparator.
+// Ties are resolved arbitrarily. The ok result is false for an empty map.
+func MapMinBy[K comparable, T any](source map[K]T, c Comparator[T]) (result KV[K, T], ok bool) {
+	for key, value := range source {
+		if !ok || c(value, result.Value) < 0 {
+			result, ok = KV[K, T]{Key: key, Value: value}, true
+		}
+	}
+
+	return result, ok
+}
+
+// MapMaxBy returns the entry of the map with the largest value according to the comparator.
+// Ties are resolved arbitrarily. The ok result is false for an empty map.
+func MapMaxBy[K comparable, T any](source map[K]T, c Comparator[T]) (result KV[K, T], ok bool) {
+	for key, value := range source {
+		if !ok || c(value, result.Value) > 0 {
+			result, ok = KV[K, T]{Key: key, Value: value}, true
+		}
+	}
+
+	return result, ok
+}
+
+// MinOfFunc returns the smallest value among the provided elements according to the comparator or zero value
+func MinOfFunc[T any](c Comparator[T], elements ...T) T {
+	var result, _ = MinBy(elements, c)
+	return result
+}
+
+// MaxOfFunc returns the largest value among the provided elements according to the comparator or zero value
+func MaxOfFunc[T any](c Comparator[T], elements ...T) T {
+	var result, _ = MaxBy(elements, c)
+	return result
+}
+
+// Clamp returns value limited to the range [low, high].
+func Clamp[T constraints.Ordered](value T, low T, high T) T {
+	return ClampFunc(value, low, high, cmp.Compare[T])
+}
+
+// ClampFunc returns value limited to the range [low, high] according to the comparator.
+func ClampFunc[T any](value T, low T, high T, c Comparator[T]) T {
+	if c(value, low) < 0 {
+		return low
+	}
+
+	if c(value, high) > 0 {
+		return high
+	}
+
+	return value
+}
@@ -1,6 +1,7 @@
 package collection_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/sergeydobrodey/collection"
@@ -311,3 +312,214 @@ func TestMapEqualFunc(t *testing.T) {
 		})
 	}
 }
+
+type employee struct {
+	name string
+	age  int
+}
+
+func TestComparator(t *testing.T) {
+	byAge := collection.ByKey(func(e employee) int { return e.age })
+	byName := collection.ByKey(func(e employee) string { return e.name })
+
+	source := []employee{{"bob", 30}, {"amy", 40}, {"eve", 30}, {"dan", 25}}
+
+	cases := []struct {
+		name string
+		cmp  collection.Comparator[employee]
+		want []employee
+	}{
+		{"by key", byAge, []employee{{"dan", 25}, {"bob", 30}, {"eve", 30}, {"amy", 40}}},
+		{"chain", collection.Chain(byAge.Reversed(), byName), []employee{{"amy", 40}, {"bob", 30}, {"eve", 30}, {"dan", 25}}},
+		{"from less", collection.ComparatorOf(func(l, r employee) bool { return l.name > r.name }), []employee{{"eve", 30}, {"dan", 25}, {"bob", 30}, {"amy", 40}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := slices.Clone(source)
+			slices.SortStableFunc(got, tc.cmp)
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("SortStableFunc() = %v; want %v", got, tc.want)
+			}
+
+			collection.SortStableBy(got, tc.cmp.Less())
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("SortStableBy(Less()) = %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNilsFirstLast(t *testing.T) {
+	one, two := 1, 2
+
+	cases := []struct {
+		name string
+		cmp  collection.Comparator[*int]
+		want []*int
+	}{
+		{"nils first", collection.NilsFirst(collection.Natural[int]()), []*int{nil, nil, &one, &two}},
+		{"nils last", collection.NilsLast(collection.Natural[int]()), []*int{&one, &two, nil, nil}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := []*int{&two, nil, &one, nil}
+			slices.SortFunc(got, tc.cmp)
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("SortFunc() = %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	byAge := collection.ByKey(func(e employee) int { return e.age })
+	source := []employee{{"bob", 30}, {"amy", 40}, {"dan", 25}, {"eve", 40}}
+
+	if got, ok := collection.MinBy(source, byAge); !ok || got != (employee{"dan", 25}) {
+		t.Errorf("MinBy() = (%v, %v); want ({dan 25}, true)", got, ok)
+	}
+
+	if got, ok := collection.MaxBy(source, byAge); !ok || got != (employee{"amy", 40}) {
+		t.Errorf("MaxBy() = (%v, %v); want ({amy 40}, true)", got, ok)
+	}
+
+	if _, ok := collection.MinBy([]employee{}, byAge); ok {
+		t.Errorf("MinBy(empty) ok = true; want false")
+	}
+
+	if got := collection.MinOfFunc(byAge, source...); got != (employee{"dan", 25}) {
+		t.Errorf("MinOfFunc() = %v; want {dan 25}", got)
+	}
+
+	if got := collection.MaxOfFunc(byAge); got != (employee{}) {
+		t.Errorf("MaxOfFunc() = %v; want zero value", got)
+	}
+}
+
+func TestMinByKeyMaxByKey(t *testing.T) {
+	var calls int
+	age := func(e employee) int {
+		calls++
+		return e.age
+	}
+	source := []employee{{"bob", 30}, {"amy", 40}, {"dan", 25}, {"eve", 40}, {"kim", 25}}
+
+	if got, ok := collection.MinByKey(source, age); !ok || got != (employee{"dan", 25}) {
+		t.Errorf("MinByKey() = (%v, %v); want ({dan 25}, true)", got, ok)
+	}
+
+	if calls != len(source) {
+		t.Errorf("MinByKey() called the key function %v times; want %v", calls, len(source))
+	}
+
+	if got, ok := collection.MaxByKey(source, age); !ok || got != (employee{"amy", 40}) {
+		t.Errorf("MaxByKey() = (%v, %v); want ({amy 40}, true)", got, ok)
+	}
+
+	if _, ok := collection.MinByKey([]employee{}, age); ok {
+		t.Errorf("MinByKey(empty) ok = true; want false")
+	}
+
+	if _, ok := collection.MaxByKey([]employee{}, age); ok {
+		t.Errorf("MaxByKey(empty) ok = true; want false")
+	}
+}
+
+func TestArgMinArgMax(t *testing.T) {
+	cases := []struct {
+		source         []int
+		argMin, argMax int
+		ok             bool
+	}{
+		{source: nil, argMin: -1, argMax: -1},
+		{source: []int{7}, argMin: 0, argMax: 0, ok: true},
+		{source: []int{3, 1, 4, 1, 5, 9, 2, 9}, argMin: 1, argMax: 5, ok: true},
+	}
+
+	for _, tc := range cases {
+		if got, ok := collection.ArgMin(tc.source); got != tc.argMin || ok != tc.ok {
+			t.Errorf("ArgMin(%v) = (%v, %v); want (%v, %v)", tc.source, got, ok, tc.argMin, tc.ok)
+		}
+
+		if got, ok := collection.ArgMax(tc.source); got != tc.argMax || ok != tc.ok {
+			t.Errorf("ArgMax(%v) = (%v, %v); want (%v, %v)", tc.source, got, ok, tc.argMax, tc.ok)
+		}
+	}
+
+	byAge := collection.ByKey(func(e employee) int { return e.age })
+	employees := []employee{{"bob", 30}, {"amy", 40}, {"dan", 25}, {"eve", 40}}
+
+	if got, ok := collection.ArgMinBy(employees, byAge); !ok || got != 2 {
+		t.Errorf("ArgMinBy() = (%v, %v); want (2, true)", got, ok)
+	}
+
+	if got, ok := collection.ArgMaxBy(employees, byAge); !ok || got != 1 {
+		t.Errorf("ArgMaxBy() = (%v, %v); want (1, true)", got, ok)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if min, max, ok := collection.MinMax([]float64{2.5, -1, 7, 0}); !ok || min != -1 || max != 7 {
+		t.Errorf("MinMax() = (%v, %v, %v); want (-1, 7, true)", min, max, ok)
+	}
+
+	if _, _, ok := collection.MinMax([]int{}); ok {
+		t.Errorf("MinMax(empty) ok = true; want false")
+	}
+
+	byAge := collection.ByKey(func(e employee) int { return e.age })
+	employees := []employee{{"bob", 30}, {"amy", 40}, {"dan", 25}, {"eve", 40}, {"kim", 25}}
+
+	min, max, ok := collection.MinMaxBy(employees, byAge)
+	if !ok || min != (employee{"dan", 25}) || max != (employee{"amy", 40}) {
+		t.Errorf("MinMaxBy() = (%v, %v, %v); want ({dan 25}, {amy 40}, true)", min, max, ok)
+	}
+}
+
+func TestMapMinByMapMaxBy(t *testing.T) {
+	stock := map[string]int{"apples": 12, "pears": 3, "plums": 40}
+
+	if got, ok := collection.MapMinBy(stock, collection.Natural[int]()); !ok || got != (collection.KV[string, int]{Key: "pears", Value: 3}) {
+		t.Errorf("MapMinBy() = (%v, %v); want ({pears 3}, true)", got, ok)
+	}
+
+	if got, ok := collection.MapMaxBy(stock, collection.Natural[int]()); !ok || got != (collection.KV[string, int]{Key: "plums", Value: 40}) {
+		t.Errorf("MapMaxBy() = (%v, %v); want ({plums 40}, true)", got, ok)
+	}
+
+	if _, ok := collection.MapMinBy(map[string]int{}, collection.Natural[int]()); ok {
+		t.Errorf("MapMinBy(empty) ok = true; want false")
+	}
+
+	if _, ok := collection.MapMaxBy(map[string]int{}, collection.Natural[int]()); ok {
+		t.Errorf("MapMaxBy(empty) ok = true; want false")
+	}
+}
+
+func TestClamp(t *testing.T) {
+	cases := []struct {
+		value int
+		want  int
+	}{
+		{-5, 0},
+		{5, 5},
+		{15, 10},
+	}
+
+	for _, tc := range cases {
+		if got := collection.Clamp(tc.value, 0, 10); got != tc.want {
+			t.Errorf("Clamp(%v, 0, 10) = %v; want %v", tc.value, got, tc.want)
+		}
+	}
+
+	byAge := collection.ByKey(func(e employee) int { return e.age })
+	low, high := employee{"min", 18}, employee{"max", 65}
+
+	if got := collection.ClampFunc(employee{"kid", 10}, low, high, byAge); got != low {
+		t.Errorf("ClampFunc() = %v; want %v", got, low)
+	}
+}
@@ -0,0 +1,108 @@
+package collection
+
+import "sort"
+
+// Count returns the number of elements of the slice that satisfy the given predicate function.
+func Count[S ~[]T, T any](source S, predicate func(T) bool) int {
+	var result int
+	for _, v := range source {
+		if predicate(v) {
+			result++
+		}
+	}
+
+	return result
+}
+
+// CountBy returns a new map with the number of elements of the slice for every key returned by the given key function.
+func CountBy[S ~[]T, T any, K comparable](source S, keyFunc func(T) K) map[K]int {
+	var result = make(map[K]int)
+	for _, v := range source {
+		result[keyFunc(v)]++
+	}
+
+	return result
+}
+
+// Frequencies returns a new map with the number of occurrences of every element of the slice.
+func Frequencies[S ~[]T, T comparable](source S) map[T]int {
+	return CountBy(source, func(v T) T { return v })
+}
+
+// MostFrequent returns the element that occurs most often in the slice.
+// Ties are resolved in favour of the element that appears first. The ok result is false for an empty slice.
+func MostFrequent[S ~[]T, T comparable](source S) (result T, count int, ok bool) {
+	return frequent(source, func(l, r int) bool { return l > r })
+}
+
+// LeastFrequent returns the element that occurs least often in the slice.
+// Ties are resolved in favour of the element that appears first. The ok result is false for an empty slice.
+func LeastFrequent[S ~[]T, T comparable](source S) (result T, count int, ok bool) {
+	return frequent(source, func(l, r int) bool { return l < r })
+}
+
+func frequent[S ~[]T, T comparable](source S, better func(l, r int) bool) (result T, count int, ok bool) {
+	var frequencies = Frequencies(source)
+
+	for _, v := range source {
+		var n = frequencies[v]
+		if !ok || better(n, count) {
+			result, count, ok = v, n, true
+		}
+	}
+
+	return result, count, ok
+}
+
+// Bucket is a single bucket of a Histogram covering the half-open range [Low, High).
+type Bucket[T Number] struct {
+	Low   T
+	High  T
+	Count int
+}
+
+// Histogram counts the elements of the slice into the buckets delimited by the given edges.
+// The edges must be sorted in ascending order; n edges produce n-1 buckets, returned in order.
+// Every bucket is half-open except the last one, which also includes its upper edge.
+// Elements outside of [edges[0], edges[n-1]] are ignored. Fewer than two edges produce a nil result.
+func Histogram[S ~[]T, T Number](source S, edges []T) []Bucket[T] {
+	if len(edges) < 2 {
+		return nil
+	}
+
+	var result = make([]Bucket[T], len(edges)-1)
+	for i := range result {
+		result[i] = Bucket[T]{Low: edges[i], High: edges[i+1]}
+	}
+
+	var last = len(edges) - 1
+	for _, v := range source {
+		var i = sort.Search(len(edges), func(i int) bool { return edges[i] > v })
+
+		switch {
+		case i == 0:
+			continue
+		case i <= last:
+			result[i-1].Count++
+		case v == edges[last]:
+			result[last-1].Count++
+		}
+	}
+
+	return result
+}
+
+// FixedWidthEdges returns the edges of n buckets of the same width starting at start, to be used with Histogram.
+// It returns nil if n or width is not positive.
+func FixedWidthEdges[T Number](start T, width T, n int) []T {
+	if n <= 0 || width <= 0 {
+		return nil
+	}
+
+	var result = make([]T, n+1)
+	for i := range result {
+		result[i] = start + T(i)*width
+	}
+
+	return result
+}
@@ -0,0 +1,160 @@
+package collection_test
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestCount(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	cases := []struct {
+		source []int
+		want   int
+	}{
+		{source: nil, want: 0},
+		{source: []int{1, 3, 5}, want: 0},
+		{source: []int{1, 2, 3, 4, 6}, want: 3},
+	}
+
+	for _, tc := range cases {
+		if got := collection.Count(tc.source, even); got != tc.want {
+			t.Errorf("Count(%v) = %v; want %v", tc.source, got, tc.want)
+		}
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	source := []string{"apple", "avocado", "banana", "cherry", "blueberry"}
+	want := map[byte]int{'a': 2, 'b': 2, 'c': 1}
+
+	got := collection.CountBy(source, func(v string) byte { return v[0] })
+	if !maps.Equal(got, want) {
+		t.Errorf("CountBy(%v) = %v; want %v", source, got, want)
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	cases := []struct {
+		source []string
+		want   map[string]int
+	}{
+		{source: []string{}, want: map[string]int{}},
+		{source: []string{"a", "b", "a", "a"}, want: map[string]int{"a": 3, "b": 1}},
+	}
+
+	for _, tc := range cases {
+		got := collection.Frequencies(tc.source)
+
+		if !maps.Equal(got, tc.want) {
+			t.Errorf("Frequencies(%v) = %v; want %v", tc.source, got, tc.want)
+		}
+	}
+}
+
+func TestMostLeastFrequent(t *testing.T) {
+	cases := []struct {
+		name       string
+		source     []string
+		most       string
+		mostCount  int
+		least      string
+		leastCount int
+		ok         bool
+	}{
+		{name: "empty", source: nil},
+		{name: "single", source: []string{"a"}, most: "a", mostCount: 1, least: "a", leastCount: 1, ok: true},
+		{name: "distinct counts", source: []string{"b", "a", "b", "c", "b", "a"}, most: "b", mostCount: 3, least: "c", leastCount: 1, ok: true},
+		{name: "ties keep first", source: []string{"x", "y", "y", "x", "z", "w"}, most: "x", mostCount: 2, least: "z", leastCount: 1, ok: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			most, mostCount, ok := collection.MostFrequent(tc.source)
+			if most != tc.most || mostCount != tc.mostCount || ok != tc.ok {
+				t.Errorf("MostFrequent(%v) = %q, %v, %v; want %q, %v, %v", tc.source, most, mostCount, ok, tc.most, tc.mostCount, tc.ok)
+			}
+
+			least, leastCount, ok := collection.LeastFrequent(tc.source)
+			if least != tc.least || leastCount != tc.leastCount || ok != tc.ok {
+				t.Errorf("LeastFrequent(%v) = %q, %v, %v; want %q, %v, %v", tc.source, least, leastCount, ok, tc.least, tc.leastCount, tc.ok)
+			}
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	type bucket = collection.Bucket[float64]
+
+	cases := []struct {
+		name   string
+		source []float64
+		edges  []float64
+		want   []bucket
+	}{
+		{name: "no edges", source: []float64{1}, edges: nil, want: nil},
+		{name: "single edge", source: []float64{1}, edges: []float64{0}, want: nil},
+		{
+			name:   "custom edges",
+			source: []float64{-1, 0, 0.5, 1, 2.5, 9.99, 10, 11, math.NaN()},
+			edges:  []float64{0, 1, 5, 10},
+			want:   []bucket{{Low: 0, High: 1, Count: 2}, {Low: 1, High: 5, Count: 2}, {Low: 5, High: 10, Count: 2}},
+		},
+		{
+			name:   "empty source",
+			source: nil,
+			edges:  []float64{0, 1},
+			want:   []bucket{{Low: 0, High: 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := collection.Histogram(tc.source, tc.edges)
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("Histogram(%v, %v) = %v; want %v", tc.source, tc.edges, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFixedWidthEdges(t *testing.T) {
+	cases := []struct {
+		start, width int
+		n            int
+		want         []int
+	}{
+		{start: 0, width: 10, n: 0, want: nil},
+		{start: 0, width: 0, n: 3, want: nil},
+		{start: 0, width: 10, n: 3, want: []int{0, 10, 20, 30}},
+		{start: -5, width: 5, n: 2, want: []int{-5, 0, 5}},
+	}
+
+	for _, tc := range cases {
+		got := collection.FixedWidthEdges(tc.start, tc.width, tc.n)
+
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("FixedWidthEdges(%v, %v, %v) = %v; want %v", tc.start, tc.width, tc.n, got, tc.want)
+		}
+	}
+}
+
+// ExampleHistogram: Example function demonstrating the use of the Histogram function.
+func ExampleHistogram() {
+	latencies := []int{12, 7, 25, 31, 18, 3, 40, 22}
+
+	for _, b := range collection.Histogram(latencies, collection.FixedWidthEdges(0, 10, 4)) {
+		fmt.Printf("[%d, %d): %d\n", b.Low, b.High, b.Count)
+	}
+	// Output:
+	// [0, 10): 2
+	// [10, 20): 2
+	// [20, 30): 2
+	// [30, 40): 2
+}
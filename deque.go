@@ -0,0 +1,200 @@
+package collection
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewDeque creates an empty Deque with room for capacity elements.
+func NewDeque[T any](capacity int) *Deque[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &Deque[T]{buf: make([]T, capacity)}
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// PushFront adds the value to the front of the deque.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+
+	d.head = d.index(-1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PushBack adds the value to the back of the deque.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+
+	d.buf[d.index(d.size)] = v
+	d.size++
+}
+
+// PopFront removes and returns the front element.
+// The ok result is false for an empty deque.
+func (d *Deque[T]) PopFront() (v T, ok bool) {
+	if d.size == 0 {
+		return v, false
+	}
+
+	var zero T
+
+	v, d.buf[d.head] = d.buf[d.head], zero
+	d.head = d.index(1)
+	d.size--
+
+	return v, true
+}
+
+// PopBack removes and returns the back element.
+// The ok result is false for an empty deque.
+func (d *Deque[T]) PopBack() (v T, ok bool) {
+	if d.size == 0 {
+		return v, false
+	}
+
+	var (
+		zero T
+		i    = d.index(d.size - 1)
+	)
+
+	v, d.buf[i] = d.buf[i], zero
+	d.size--
+
+	return v, true
+}
+
+// Front returns the front element without removing it.
+// The ok result is false for an empty deque.
+func (d *Deque[T]) Front() (v T, ok bool) {
+	if d.size == 0 {
+		return v, false
+	}
+
+	return d.buf[d.head], true
+}
+
+// Back returns the back element without removing it.
+// The ok result is false for an empty deque.
+func (d *Deque[T]) Back() (v T, ok bool) {
+	if d.size == 0 {
+		return v, false
+	}
+
+	return d.buf[d.index(d.size-1)], true
+}
+
+// At returns the element at position i counted from the front. It panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	d.check(i)
+
+	return d.buf[d.index(i)]
+}
+
+// Set replaces the element at position i counted from the front. It panics if i is out of range.
+func (d *Deque[T]) Set(i int, v T) {
+	d.check(i)
+
+	d.buf[d.index(i)] = v
+}
+
+// Rotate rotates the deque n steps to the back: the last n elements move to the front.
+// A negative n rotates to the front.
+func (d *Deque[T]) Rotate(n int) {
+	if d.size <= 1 {
+		return
+	}
+
+	n %= d.size
+	if n == 0 {
+		return
+	}
+
+	if d.size == len(d.buf) {
+		d.head = d.index(-n)
+		return
+	}
+
+	for ; n > 0; n-- {
+		var v, _ = d.PopBack()
+		d.PushFront(v)
+	}
+
+	for ; n < 0; n++ {
+		var v, _ = d.PopFront()
+		d.PushBack(v)
+	}
+}
+
+// Range calls f sequentially for each element from the front to the back.
+// If f returns false, range stops the iteration.
+func (d *Deque[T]) Range(f func(i int, v T) bool) {
+	for i := 0; i < d.size; i++ {
+		if !f(i, d.buf[d.index(i)]) {
+			return
+		}
+	}
+}
+
+// ToSlice returns a new slice with the elements from the front to the back.
+func (d *Deque[T]) ToSlice() []T {
+	var result = make([]T, d.size)
+
+	var n = copy(result, d.buf[d.head:])
+	if n < d.size {
+		copy(result[n:], d.buf[:d.size-n])
+	}
+
+	return result
+}
+
+// Clone returns a shallow copy of the deque.
+func (d *Deque[T]) Clone() *Deque[T] {
+	return &Deque[T]{buf: d.ToSlice(), size: d.size}
+}
+
+// Clear removes all elements from the deque keeping its capacity.
+func (d *Deque[T]) Clear() {
+	var zero T
+	for i := range d.buf {
+		d.buf[i] = zero
+	}
+
+	d.head, d.size = 0, 0
+}
+
+func (d *Deque[T]) index(i int) int {
+	var n = len(d.buf)
+	return ((d.head+i)%n + n) % n
+}
+
+func (d *Deque[T]) check(i int) {
+	if i < 0 || i >= d.size {
+		panic("collection: deque index out of range")
+	}
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	var capacity = 2 * len(d.buf)
+	if capacity == 0 {
+		capacity = 8
+	}
+
+	var buf = make([]T, capacity)
+	copy(buf, d.ToSlice())
+
+	d.buf, d.head = buf, 0
+}
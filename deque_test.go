@@ -0,0 +1,136 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestDeque(t *testing.T) {
+	var d collection.Deque[int]
+
+	for i := 0; i < 10; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+
+	want := []int{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if got := d.ToSlice(); !slices.Equal(got, want) || d.Len() != len(want) {
+		t.Fatalf("ToSlice() = %v; want %v", got, want)
+	}
+
+	if front, ok := d.Front(); !ok || front != -10 {
+		t.Errorf("Front() = (%v, %v); want (-10, true)", front, ok)
+	}
+
+	if back, ok := d.Back(); !ok || back != 9 {
+		t.Errorf("Back() = (%v, %v); want (9, true)", back, ok)
+	}
+
+	if got := d.At(10); got != 0 {
+		t.Errorf("At(10) = %v; want 0", got)
+	}
+
+	d.Set(10, 100)
+	if got := d.At(10); got != 100 {
+		t.Errorf("Set(10, 100); At(10) = %v; want 100", got)
+	}
+
+	for _, want := range []int{-10, -9} {
+		if got, ok := d.PopFront(); !ok || got != want {
+			t.Errorf("PopFront() = (%v, %v); want (%v, true)", got, ok, want)
+		}
+	}
+
+	for _, want := range []int{9, 8} {
+		if got, ok := d.PopBack(); !ok || got != want {
+			t.Errorf("PopBack() = (%v, %v); want (%v, true)", got, ok, want)
+		}
+	}
+
+	d.Clear()
+	if _, ok := d.PopFront(); ok || d.Len() != 0 {
+		t.Errorf("PopFront() after Clear ok = true; want false")
+	}
+
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("PopBack() on empty deque ok = true; want false")
+	}
+}
+
+func TestDequeRotate(t *testing.T) {
+	cases := []struct {
+		name     string
+		capacity int
+		n        int
+		want     []int
+	}{
+		{"right", 10, 2, []int{4, 5, 1, 2, 3}},
+		{"left", 10, -2, []int{3, 4, 5, 1, 2}},
+		{"full buffer", 5, 2, []int{4, 5, 1, 2, 3}},
+		{"full buffer left", 5, -7, []int{3, 4, 5, 1, 2}},
+		{"whole turn", 10, 5, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := collection.NewDeque[int](tc.capacity)
+			for i := 1; i <= 5; i++ {
+				d.PushBack(i)
+			}
+
+			d.Rotate(tc.n)
+
+			if got := d.ToSlice(); !slices.Equal(got, tc.want) {
+				t.Errorf("Rotate(%d) = %v; want %v", tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDequeCloneRange(t *testing.T) {
+	d := collection.NewDeque[int](2)
+	for i := 0; i < 5; i++ {
+		d.PushFront(i)
+	}
+
+	clone := d.Clone()
+	clone.PushBack(100)
+
+	if got := d.ToSlice(); !slices.Equal(got, []int{4, 3, 2, 1, 0}) {
+		t.Errorf("Clone() changed the original deque: %v", got)
+	}
+
+	var got []int
+	clone.Range(func(i int, v int) bool {
+		got = append(got, v)
+		return i < 2
+	})
+
+	if !slices.Equal(got, []int{4, 3, 2}) {
+		t.Errorf("Range() = %v; want %v", got, []int{4, 3, 2})
+	}
+}
+
+func TestDequeAtOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("At() out of range did not panic")
+		}
+	}()
+
+	var d collection.Deque[int]
+	d.At(0)
+}
+
+func ExampleDeque() {
+	var d collection.Deque[string]
+	d.PushBack("b")
+	d.PushFront("a")
+	d.PushBack("c")
+
+	fmt.Println(collection.FilterBy(d.ToSlice(), func(s string) bool { return s != "b" }))
+	// Output: [a c]
+}
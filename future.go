@@ -0,0 +1,170 @@
+package collection
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoFutures is returned by AwaitAny and AwaitFirstSuccess when called without futures.
+var ErrNoFutures = errors.New("collection: no futures")
+
+// Future is the result of an asynchronous computation that can be awaited.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Go runs fn in a new goroutine and returns a Future of its result.
+func Go[T any](ctx context.Context, fn func(context.Context) (T, error)) *Future[T] {
+	var f = &Future[T]{done: make(chan struct{})}
+
+	go func() {
+		defer close(f.done)
+
+		f.value, f.err = fn(ctx)
+	}()
+
+	return f
+}
+
+// Done returns a channel that is closed when the future is resolved.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits for the future to be resolved and returns its result.
+// If the context is done first, Await returns the context error.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// FutureThen returns a Future resolved by applying fn to the result of f.
+// If f fails, fn is not called and the returned Future fails with the same error.
+func FutureThen[T, K any](ctx context.Context, f *Future[T], fn func(context.Context, T) (K, error)) *Future[K] {
+	return Go(ctx, func(ctx context.Context) (K, error) {
+		var value, err = f.Await(ctx)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+
+		return fn(ctx, value)
+	})
+}
+
+// FutureMap returns a Future resolved by transforming the value of f with the provided transform function.
+// If f fails, the returned Future fails with the same error; if the context is done first, it fails with the context error.
+func FutureMap[T, K any](ctx context.Context, f *Future[T], transform func(T) K) *Future[K] {
+	return FutureThen(ctx, f, func(_ context.Context, value T) (K, error) {
+		return transform(value), nil
+	})
+}
+
+// AwaitAll waits for all futures and returns their values in order.
+// If any future fails, AwaitAll returns the joined errors.
+func AwaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	var (
+		result = make([]T, len(futures))
+		errs   []error
+	)
+
+	for i, f := range futures {
+		var value, err = f.Await(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+
+			errs = append(errs, err)
+			continue
+		}
+
+		result[i] = value
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AwaitAny waits for the first future to be resolved and returns its result, whether successful or not.
+func AwaitAny[T any](ctx context.Context, futures ...*Future[T]) (T, error) {
+	var zero T
+
+	if len(futures) == 0 {
+		return zero, ErrNoFutures
+	}
+
+	select {
+	case f := <-firstResolved(ctx, futures):
+		return f.value, f.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// AwaitFirstSuccess waits for the first future to succeed and returns its value.
+// If all futures fail, AwaitFirstSuccess returns the joined errors.
+func AwaitFirstSuccess[T any](ctx context.Context, futures ...*Future[T]) (T, error) {
+	var zero T
+
+	if len(futures) == 0 {
+		return zero, ErrNoFutures
+	}
+
+	var (
+		resolved = firstResolved(ctx, futures)
+		errs     []error
+	)
+
+	for range futures {
+		select {
+		case f := <-resolved:
+			if f.err == nil {
+				return f.value, nil
+			}
+
+			errs = append(errs, f.err)
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+
+	return zero, errors.Join(errs...)
+}
+
+// firstResolved delivers the futures in the order they are resolved until the context is done.
+func firstResolved[T any](ctx context.Context, futures []*Future[T]) <-chan *Future[T] {
+	var resolved = make(chan *Future[T], len(futures))
+
+	for _, f := range futures {
+		go func(f *Future[T]) {
+			select {
+			case <-f.done:
+				resolved <- f
+			case <-ctx.Done():
+			}
+		}(f)
+	}
+
+	return resolved
+}
+
+// FutureTransformBy starts an async transform of every element of the source slice of type T
+// and returns the futures of the results in order, so that each one can be awaited independently.
+func FutureTransformBy[S ~[]T, T, K any](ctx context.Context, source S, transform func(context.Context, T) (K, error)) []*Future[K] {
+	return TransformBy(source, func(item T) *Future[K] {
+		return Go(ctx, func(ctx context.Context) (K, error) {
+			return transform(ctx, item)
+		})
+	})
+}
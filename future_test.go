@@ -0,0 +1,150 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func resolved[T any](value T, err error) *collection.Future[T] {
+	return collection.Go(context.Background(), func(context.Context) (T, error) {
+		return value, err
+	})
+}
+
+func never[T any]() *collection.Future[T] {
+	return collection.Go(context.Background(), func(context.Context) (T, error) {
+		select {}
+	})
+}
+
+func TestFutureAwait(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	value, err := resolved(1, nil).Await(context.Background())
+	if value != 1 || err != nil {
+		t.Errorf("Await() = (%v, %v); want (1, nil)", value, err)
+	}
+
+	if _, err = resolved(0, errFailed).Await(context.Background()); !errors.Is(err, errFailed) {
+		t.Errorf("Await() = %v; want %v", err, errFailed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err = never[int]().Await(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Await() = %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestFutureThen(t *testing.T) {
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+
+	square := collection.FutureThen(ctx, resolved(3, nil), func(_ context.Context, v int) (int, error) {
+		return v * v, nil
+	})
+
+	text := collection.FutureMap(ctx, square, strconv.Itoa)
+	if got, err := text.Await(ctx); got != "9" || err != nil {
+		t.Errorf("FutureMap().Await() = (%v, %v); want (9, nil)", got, err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	pending := collection.FutureMap(canceled, never[int](), strconv.Itoa)
+	cancel()
+
+	if _, err := pending.Await(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("FutureMap().Await() after cancel = %v; want %v", err, context.Canceled)
+	}
+
+	called := false
+	failed := collection.FutureThen(ctx, resolved(0, errFailed), func(_ context.Context, v int) (int, error) {
+		called = true
+		return v, nil
+	})
+
+	if _, err := failed.Await(ctx); !errors.Is(err, errFailed) || called {
+		t.Errorf("FutureThen().Await() = %v, called %v; want %v, false", err, called, errFailed)
+	}
+}
+
+func TestAwaitAll(t *testing.T) {
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+
+	got, err := collection.AwaitAll(ctx, resolved(1, nil), resolved(2, nil), resolved(3, nil))
+	if err != nil || !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("AwaitAll() = (%v, %v); want ([1 2 3], nil)", got, err)
+	}
+
+	if _, err = collection.AwaitAll(ctx, resolved(1, nil), resolved(0, errFailed)); !errors.Is(err, errFailed) {
+		t.Errorf("AwaitAll() = %v; want %v", err, errFailed)
+	}
+}
+
+func TestAwaitAny(t *testing.T) {
+	ctx := context.Background()
+
+	got, err := collection.AwaitAny(ctx, never[int](), resolved(2, nil))
+	if got != 2 || err != nil {
+		t.Errorf("AwaitAny() = (%v, %v); want (2, nil)", got, err)
+	}
+
+	if _, err = collection.AwaitAny[int](ctx); !errors.Is(err, collection.ErrNoFutures) {
+		t.Errorf("AwaitAny() = %v; want %v", err, collection.ErrNoFutures)
+	}
+}
+
+func TestAwaitFirstSuccess(t *testing.T) {
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+
+	got, err := collection.AwaitFirstSuccess(ctx, resolved(0, errFailed), resolved(2, nil))
+	if got != 2 || err != nil {
+		t.Errorf("AwaitFirstSuccess() = (%v, %v); want (2, nil)", got, err)
+	}
+
+	if _, err = collection.AwaitFirstSuccess(ctx, resolved(0, errFailed), resolved(0, errFailed)); !errors.Is(err, errFailed) {
+		t.Errorf("AwaitFirstSuccess() = %v; want %v", err, errFailed)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err = collection.AwaitFirstSuccess(timeout, resolved(0, errFailed), never[int]()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("AwaitFirstSuccess() = %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestFutureTransformBy(t *testing.T) {
+	ctx := context.Background()
+
+	futures := collection.FutureTransformBy(ctx, []int{1, 2, 3}, func(_ context.Context, v int) (string, error) {
+		return strconv.Itoa(v * 10), nil
+	})
+
+	got, err := collection.AwaitAll(ctx, futures...)
+	if err != nil || !slices.Equal(got, []string{"10", "20", "30"}) {
+		t.Errorf("FutureTransformBy() = (%v, %v); want ([10 20 30], nil)", got, err)
+	}
+}
+
+func ExampleGo() {
+	ctx := context.Background()
+
+	future := collection.Go(ctx, func(context.Context) (int, error) {
+		return 42, nil
+	})
+
+	value, err := future.Await(ctx)
+	fmt.Println(value, err)
+	// Output: 42 <nil>
+}
@@ -12,3 +12,18 @@ func GroupBy[S ~[]T, T any, K comparable](source S, keyFunc func(T) K) map[K]S {
 
 	return result
 }
+
+// GroupByMultiMap groups the elements of the slice into a new MultiMap by a key returned by the given key function.
+func GroupByMultiMap[S ~[]T, T comparable, K comparable](source S, keyFunc func(T) K) *MultiMap[K, T] {
+	var result = NewMultiMap[K, T]()
+	GroupByInto(result, source, keyFunc)
+
+	return result
+}
+
+// GroupByInto groups the elements of the slice into the target MultiMap by a key returned by the given key function.
+func GroupByInto[S ~[]T, T comparable, K comparable](target *MultiMap[K, T], source S, keyFunc func(T) K) {
+	for _, v := range source {
+		target.Put(keyFunc(v), v)
+	}
+}
@@ -35,3 +35,21 @@ func TestGroupBy(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupByMultiMap(t *testing.T) {
+	source := []string{"apple", "avocado", "banana", "apple"}
+	firstLetter := func(s string) byte { return s[0] }
+
+	got := collection.GroupByMultiMap(source, firstLetter)
+
+	if !slices.Equal(got.Get('a'), []string{"apple", "avocado", "apple"}) || !slices.Equal(got.Get('b'), []string{"banana"}) {
+		t.Errorf("GroupByMultiMap(%v) = %v", source, got.ToMap())
+	}
+
+	set := collection.NewSetMultiMap[byte, string]()
+	collection.GroupByInto(set, source, firstLetter)
+
+	if !slices.Equal(set.Get('a'), []string{"apple", "avocado"}) || set.ValueCount() != 3 {
+		t.Errorf("GroupByInto(set) = %v", set.ToMap())
+	}
+}
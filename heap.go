@@ -0,0 +1,272 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrQueueClosed is returned by the queue operations called after Close.
+var ErrQueueClosed = errors.New("collection: queue closed")
+
+// Heap is a binary heap ordered by a less function: the smallest element according to less is on top.
+type Heap[T any] struct {
+	items  []T
+	less   func(l T, r T) bool
+	onMove func(item T, index int)
+}
+
+// NewHeap creates an empty Heap ordered by the less function.
+func NewHeap[T any](less func(l T, r T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// NewMinHeap creates an empty Heap with the smallest element on top.
+func NewMinHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(ascending[T])
+}
+
+// NewMaxHeap creates an empty Heap with the largest element on top.
+func NewMaxHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(Less[T](ascending[T]).Reversed())
+}
+
+// NewIndexedHeap creates an empty Heap ordered by the less function that calls onMove with the new index
+// of every element placed or moved in the heap. The indexes can be passed to Update and Remove.
+// An element removed by Pop or Remove is reported with the index -1.
+func NewIndexedHeap[T any](less func(l T, r T) bool, onMove func(item T, index int)) *Heap[T] {
+	return &Heap[T]{less: less, onMove: onMove}
+}
+
+// HeapFrom creates a Heap ordered by the less function from the elements of the source slice in O(n) time.
+// The heap takes ownership of the slice.
+func HeapFrom[S ~[]T, T any](source S, less func(l T, r T) bool) *Heap[T] {
+	var h = &Heap[T]{items: source, less: less}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Push adds the value to the heap in O(log n) time.
+func (h *Heap[T]) Push(v T) {
+	h.items = append(h.items, v)
+	h.moved(len(h.items) - 1)
+	h.up(len(h.items) - 1)
+}
+
+// Peek returns the top element without removing it.
+// The ok result is false for an empty heap.
+func (h *Heap[T]) Peek() (v T, ok bool) {
+	if len(h.items) == 0 {
+		return v, false
+	}
+
+	return h.items[0], true
+}
+
+// Pop removes and returns the top element in O(log n) time.
+// The ok result is false for an empty heap.
+func (h *Heap[T]) Pop() (v T, ok bool) {
+	if len(h.items) == 0 {
+		return v, false
+	}
+
+	return h.Remove(0), true
+}
+
+// Update replaces the element at index i with v and restores the heap order in O(log n) time.
+func (h *Heap[T]) Update(i int, v T) {
+	h.items[i] = v
+	h.moved(i)
+	h.fix(i)
+}
+
+// Remove removes and returns the element at index i in O(log n) time.
+func (h *Heap[T]) Remove(i int) T {
+	var (
+		last    = len(h.items) - 1
+		removed = h.items[i]
+		zero    T
+	)
+
+	if i != last {
+		h.swap(i, last)
+	}
+
+	h.items[last] = zero
+	h.items = h.items[:last]
+
+	if h.onMove != nil {
+		h.onMove(removed, -1)
+	}
+
+	if i != last {
+		h.fix(i)
+	}
+
+	return removed
+}
+
+func (h *Heap[T]) fix(i int) {
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+func (h *Heap[T]) moved(i int) {
+	if h.onMove != nil {
+		h.onMove(h.items[i], i)
+	}
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.moved(i)
+	h.moved(j)
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		var parent = (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			break
+		}
+
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at index i down and reports whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	var start = i
+
+	for {
+		var (
+			smallest = i
+			left     = 2*i + 1
+			right    = left + 1
+		)
+
+		if left < len(h.items) && h.less(h.items[left], h.items[smallest]) {
+			smallest = left
+		}
+
+		if right < len(h.items) && h.less(h.items[right], h.items[smallest]) {
+			smallest = right
+		}
+
+		if smallest == i {
+			return i != start
+		}
+
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// PriorityQueue is a concurrency-safe priority queue backed by a Heap whose Pop blocks until an element is available.
+type PriorityQueue[T any] struct {
+	mu     sync.Mutex
+	heap   *Heap[T]
+	ready  chan struct{}
+	closed bool
+}
+
+// NewPriorityQueue creates an empty PriorityQueue ordered by the less function.
+func NewPriorityQueue[T any](less func(l T, r T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{heap: NewHeap(less), ready: make(chan struct{})}
+}
+
+// Push adds the value to the queue and wakes up the waiting consumers.
+// It returns ErrQueueClosed after Close.
+func (q *PriorityQueue[T]) Push(v T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	q.heap.Push(v)
+	q.ready = broadcast(q.ready)
+
+	return nil
+}
+
+// Pop removes and returns the top element, waiting for one until the context is done.
+// Once the queue is closed the remaining elements are still returned, then Pop returns ErrQueueClosed.
+func (q *PriorityQueue[T]) Pop(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+
+		if v, ok := q.heap.Pop(); ok {
+			q.mu.Unlock()
+			return v, nil
+		}
+
+		var (
+			closed = q.closed
+			ready  = q.ready
+		)
+
+		q.mu.Unlock()
+
+		var zero T
+		if closed {
+			return zero, ErrQueueClosed
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryPop removes and returns the top element without waiting.
+// The ok result is false for an empty queue.
+func (q *PriorityQueue[T]) TryPop() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.heap.Pop()
+}
+
+// Peek returns the top element without removing it.
+// The ok result is false for an empty queue.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.heap.Peek()
+}
+
+// Len returns the number of elements in the queue.
+func (q *PriorityQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.heap.Len()
+}
+
+// Close closes the queue for new elements and wakes up the waiting consumers.
+func (q *PriorityQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.closed {
+		q.closed = true
+		q.ready = broadcast(q.ready)
+	}
+}
@@ -0,0 +1,181 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func popAll[T any](h *collection.Heap[T]) []T {
+	var result []T
+	for {
+		v, ok := h.Pop()
+		if !ok {
+			return result
+		}
+
+		result = append(result, v)
+	}
+}
+
+func TestHeap(t *testing.T) {
+	source := randomInts(200)
+	sorted := slices.Clone(source)
+	slices.Sort(sorted)
+
+	min := collection.NewMinHeap[int]()
+	for _, v := range source {
+		min.Push(v)
+	}
+
+	if top, ok := min.Peek(); !ok || top != sorted[0] || min.Len() != len(source) {
+		t.Errorf("Peek() = (%v, %v), Len() = %v; want (%v, true), %v", top, ok, min.Len(), sorted[0], len(source))
+	}
+
+	if got := popAll(min); !slices.Equal(got, sorted) {
+		t.Errorf("NewMinHeap() pops = %v; want %v", got, sorted)
+	}
+
+	if _, ok := min.Pop(); ok {
+		t.Errorf("Pop() on empty heap ok = true; want false")
+	}
+
+	max := collection.NewMaxHeap[int]()
+	for _, v := range source {
+		max.Push(v)
+	}
+
+	reversed := slices.Clone(sorted)
+	slices.Reverse(reversed)
+
+	if got := popAll(max); !slices.Equal(got, reversed) {
+		t.Errorf("NewMaxHeap() pops = %v; want %v", got, reversed)
+	}
+
+	heapified := collection.HeapFrom(slices.Clone(source), func(l, r int) bool { return l < r })
+	if got := popAll(heapified); !slices.Equal(got, sorted) {
+		t.Errorf("HeapFrom() pops = %v; want %v", got, sorted)
+	}
+}
+
+type task struct {
+	name     string
+	priority int
+	index    int
+}
+
+func TestIndexedHeap(t *testing.T) {
+	h := collection.NewIndexedHeap(
+		func(l, r *task) bool { return l.priority < r.priority },
+		func(item *task, index int) { item.index = index },
+	)
+
+	tasks := map[string]*task{}
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		tasks[name] = &task{name: name, priority: i}
+		h.Push(tasks[name])
+	}
+
+	tasks["d"].priority = -1
+	h.Update(tasks["d"].index, tasks["d"])
+
+	if removed := h.Remove(tasks["b"].index); removed != tasks["b"] {
+		t.Errorf("Remove() = %v; want %v", removed.name, "b")
+	}
+
+	if index := tasks["b"].index; index != -1 {
+		t.Errorf("index of removed task = %v; want -1", index)
+	}
+
+	popped := popAll(h)
+	got := collection.TransformBy(popped, func(v *task) string { return v.name })
+	if want := []string{"d", "a", "c", "e"}; !slices.Equal(got, want) {
+		t.Errorf("IndexedHeap pops = %v; want %v", got, want)
+	}
+
+	for _, v := range popped {
+		if v.index != -1 {
+			t.Errorf("index of popped task %v = %v; want -1", v.name, v.index)
+		}
+	}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	ctx := context.Background()
+	q := collection.NewPriorityQueue(func(l, r int) bool { return l < r })
+
+	popped := make(chan int)
+	go func() {
+		v, _ := q.Pop(ctx)
+		popped <- v
+	}()
+
+	expectIdle(t, popped)
+
+	if err := q.Push(3); err != nil {
+		t.Fatalf("Push() = %v; want nil", err)
+	}
+
+	if got := receive(t, popped); got != 3 {
+		t.Errorf("Pop() = %v; want 3", got)
+	}
+
+	_ = q.Push(2)
+	_ = q.Push(1)
+
+	if top, ok := q.Peek(); !ok || top != 1 || q.Len() != 2 {
+		t.Errorf("Peek() = (%v, %v), Len() = %v; want (1, true), 2", top, ok, q.Len())
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	q.Close()
+
+	if err := q.Push(4); !errors.Is(err, collection.ErrQueueClosed) {
+		t.Errorf("Push() after Close = %v; want %v", err, collection.ErrQueueClosed)
+	}
+
+	for _, want := range []int{1, 2} {
+		if got, err := q.Pop(timeout); got != want || err != nil {
+			t.Errorf("Pop() = (%v, %v); want (%v, nil)", got, err, want)
+		}
+	}
+
+	if _, err := q.Pop(timeout); !errors.Is(err, collection.ErrQueueClosed) {
+		t.Errorf("Pop() on closed queue = %v; want %v", err, collection.ErrQueueClosed)
+	}
+
+	if _, ok := q.TryPop(); ok {
+		t.Errorf("TryPop() on empty queue ok = true; want false")
+	}
+}
+
+func TestPriorityQueuePopCanceled(t *testing.T) {
+	q := collection.NewPriorityQueue(func(l, r int) bool { return l < r })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := q.Pop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pop() = %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func ExampleNewMinHeap() {
+	h := collection.NewMinHeap[int]()
+	h.Push(5)
+	h.Push(1)
+	h.Push(3)
+
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		fmt.Print(v, " ")
+	}
+	// Output: 1 3 5
+}
@@ -0,0 +1,261 @@
+package collection
+
+// Element is an element of a List. It is a handle for O(1) insertion, removal and moves.
+type Element[T any] struct {
+	Value T
+
+	next, prev *Element[T]
+	list       *List[T]
+}
+
+// Next returns the next list element or nil.
+func (e *Element[T]) Next() *Element[T] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+
+	return nil
+}
+
+// Prev returns the previous list element or nil.
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+
+	return nil
+}
+
+// List is a doubly linked list. The zero value is an empty list ready to use.
+type List[T any] struct {
+	root Element[T]
+	len  int
+}
+
+// NewList creates an empty List.
+func NewList[T any]() *List[T] {
+	return new(List[T]).lazyInit()
+}
+
+// ListFrom creates a List with the elements of the source slice in order.
+func ListFrom[S ~[]T, T any](source S) *List[T] {
+	var l = NewList[T]()
+	for _, v := range source {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+func (l *List[T]) lazyInit() *List[T] {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+
+	return l
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element of the list or nil.
+func (l *List[T]) Front() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.next
+}
+
+// Back returns the last element of the list or nil.
+func (l *List[T]) Back() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
+
+// PushFront inserts the value at the front of the list and returns its element.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: v}, &l.root)
+}
+
+// PushBack inserts the value at the back of the list and returns its element.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: v}, l.root.prev)
+}
+
+// InsertBefore inserts the value immediately before mark and returns its element.
+// If mark is not an element of the list, the list is not modified and nil is returned.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insert(&Element[T]{Value: v}, mark.prev)
+}
+
+// InsertAfter inserts the value immediately after mark and returns its element.
+// If mark is not an element of the list, the list is not modified and nil is returned.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+
+	return l.insert(&Element[T]{Value: v}, mark)
+}
+
+// Remove removes the element from the list if it belongs to it and returns its value.
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+
+	return e.Value
+}
+
+// MoveToFront moves the element to the front of the list.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+
+	l.move(e, &l.root)
+}
+
+// MoveToBack moves the element to the back of the list.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+
+	l.move(e, l.root.prev)
+}
+
+// MoveBefore moves the element to its new position before mark.
+// If either element does not belong to the list or they are the same, the list is not modified.
+func (l *List[T]) MoveBefore(e, mark *Element[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+
+	l.move(e, mark.prev)
+}
+
+// MoveAfter moves the element to its new position after mark.
+// If either element does not belong to the list or they are the same, the list is not modified.
+func (l *List[T]) MoveAfter(e, mark *Element[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+
+	l.move(e, mark)
+}
+
+// SpliceBack moves all elements of other to the back of the list, leaving other empty.
+// The element handles stay valid and now belong to the list.
+func (l *List[T]) SpliceBack(other *List[T]) {
+	l.splice(other, l.lazyInit().root.prev)
+}
+
+// SpliceFront moves all elements of other to the front of the list, leaving other empty.
+// The element handles stay valid and now belong to the list.
+func (l *List[T]) SpliceFront(other *List[T]) {
+	l.splice(other, &l.lazyInit().root)
+}
+
+// Range calls f sequentially for each element value from the front to the back.
+// If f returns false, range stops the iteration.
+func (l *List[T]) Range(f func(i int, v T) bool) {
+	var i int
+	for e := l.Front(); e != nil; e = e.Next() {
+		if !f(i, e.Value) {
+			return
+		}
+
+		i++
+	}
+}
+
+// RangeBackward calls f sequentially for each element value from the back to the front.
+// If f returns false, range stops the iteration.
+func (l *List[T]) RangeBackward(f func(i int, v T) bool) {
+	var i = l.len - 1
+	for e := l.Back(); e != nil; e = e.Prev() {
+		if !f(i, e.Value) {
+			return
+		}
+
+		i--
+	}
+}
+
+// ToSlice returns a new slice with the element values from the front to the back.
+func (l *List[T]) ToSlice() []T {
+	var result = make([]T, 0, l.len)
+	l.Range(func(_ int, v T) bool {
+		result = append(result, v)
+		return true
+	})
+
+	return result
+}
+
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+
+	return e
+}
+
+func (l *List[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev, e.list = nil, nil, nil
+	l.len--
+}
+
+func (l *List[T]) move(e, at *Element[T]) {
+	if e == at || e.prev == at {
+		return
+	}
+
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+func (l *List[T]) splice(other *List[T], at *Element[T]) {
+	if other == l || other.len == 0 {
+		return
+	}
+
+	var first, last = other.root.next, other.root.prev
+	for e := first; e != &other.root; e = e.next {
+		e.list = l
+	}
+
+	first.prev = at
+	last.next = at.next
+	at.next.prev = last
+	at.next = first
+
+	l.len += other.len
+
+	other.root.next, other.root.prev = &other.root, &other.root
+	other.len = 0
+}
@@ -0,0 +1,146 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestList(t *testing.T) {
+	var l collection.List[int]
+
+	if l.Front() != nil || l.Back() != nil || l.Len() != 0 {
+		t.Fatalf("zero List is not empty")
+	}
+
+	two := l.PushBack(2)
+	one := l.PushFront(1)
+	four := l.PushBack(4)
+	three := l.InsertBefore(3, four)
+	five := l.InsertAfter(5, four)
+
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 5}) || l.Len() != 5 {
+		t.Fatalf("ToSlice() = %v; want %v", got, []int{1, 2, 3, 4, 5})
+	}
+
+	if one.Prev() != nil || one.Next() != two || five.Next() != nil || five.Prev() != four {
+		t.Errorf("Next()/Prev() links are broken")
+	}
+
+	if got := l.Remove(three); got != 3 || l.Len() != 4 {
+		t.Errorf("Remove() = %v, Len() = %v; want 3, 4", got, l.Len())
+	}
+
+	if got := l.Remove(three); got != 3 || l.Len() != 4 {
+		t.Errorf("Remove() of removed element changed Len() to %v", l.Len())
+	}
+
+	other := collection.NewList[int]()
+	if other.InsertBefore(0, one) != nil {
+		t.Errorf("InsertBefore() with foreign mark != nil")
+	}
+}
+
+func TestListMoves(t *testing.T) {
+	cases := []struct {
+		name string
+		move func(l *collection.List[string], e map[string]*collection.Element[string])
+		want []string
+	}{
+		{"to front", func(l *collection.List[string], e map[string]*collection.Element[string]) { l.MoveToFront(e["c"]) }, []string{"c", "a", "b", "d"}},
+		{"to back", func(l *collection.List[string], e map[string]*collection.Element[string]) { l.MoveToBack(e["a"]) }, []string{"b", "c", "d", "a"}},
+		{"before", func(l *collection.List[string], e map[string]*collection.Element[string]) {
+			l.MoveBefore(e["d"], e["b"])
+		}, []string{"a", "d", "b", "c"}},
+		{"after", func(l *collection.List[string], e map[string]*collection.Element[string]) {
+			l.MoveAfter(e["a"], e["c"])
+		}, []string{"b", "c", "a", "d"}},
+		{"after itself", func(l *collection.List[string], e map[string]*collection.Element[string]) {
+			l.MoveAfter(e["b"], e["b"])
+		}, []string{"a", "b", "c", "d"}},
+		{"already in place", func(l *collection.List[string], e map[string]*collection.Element[string]) {
+			l.MoveAfter(e["b"], e["a"])
+		}, []string{"a", "b", "c", "d"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := collection.NewList[string]()
+			elements := map[string]*collection.Element[string]{}
+
+			for _, v := range []string{"a", "b", "c", "d"} {
+				elements[v] = l.PushBack(v)
+			}
+
+			tc.move(l, elements)
+
+			if got := l.ToSlice(); !slices.Equal(got, tc.want) {
+				t.Errorf("ToSlice() = %v; want %v", got, tc.want)
+			}
+
+			var backward []string
+			l.RangeBackward(func(_ int, v string) bool {
+				backward = append(backward, v)
+				return true
+			})
+
+			slices.Reverse(backward)
+			if !slices.Equal(backward, tc.want) {
+				t.Errorf("RangeBackward() = %v; want reversed %v", backward, tc.want)
+			}
+		})
+	}
+}
+
+func TestListSplice(t *testing.T) {
+	l := collection.ListFrom([]int{3, 4})
+	front := collection.ListFrom([]int{1, 2})
+	back := collection.ListFrom([]int{5, 6})
+	six := back.Back()
+
+	l.SpliceFront(front)
+	l.SpliceBack(back)
+
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 5, 6}) || l.Len() != 6 {
+		t.Errorf("ToSlice() = %v; want %v", got, []int{1, 2, 3, 4, 5, 6})
+	}
+
+	if front.Len() != 0 || back.Len() != 0 || back.Front() != nil {
+		t.Errorf("spliced lists are not empty")
+	}
+
+	l.MoveToFront(six)
+	if got := l.ToSlice(); !slices.Equal(got, []int{6, 1, 2, 3, 4, 5}) {
+		t.Errorf("MoveToFront(spliced element) = %v; want %v", got, []int{6, 1, 2, 3, 4, 5})
+	}
+
+	back.PushBack(7)
+	l.SpliceBack(back)
+	if got := l.ToSlice(); !slices.Equal(got, []int{6, 1, 2, 3, 4, 5, 7}) {
+		t.Errorf("SpliceBack() of reused list = %v", got)
+	}
+}
+
+func TestListRange(t *testing.T) {
+	l := collection.ListFrom([]int{1, 2, 3, 4})
+
+	var got []int
+	l.Range(func(i int, v int) bool {
+		got = append(got, v)
+		return i < 1
+	})
+
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Range() = %v; want %v", got, []int{1, 2})
+	}
+}
+
+func ExampleListFrom() {
+	l := collection.ListFrom(collection.FilterBy([]int{1, 2, 3, 4, 5}, func(v int) bool { return v%2 == 1 }))
+	l.PushFront(0)
+
+	fmt.Println(collection.TransformBy(l.ToSlice(), func(v int) int { return v * 10 }))
+	// Output: [0 10 30 50]
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 )
 
+// Pair represents a pair of values of possibly different types.
 type Pair[T any, V any] struct {
 	First  T
 	Second V
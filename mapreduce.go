@@ -0,0 +1,147 @@
+package collection
+
+import (
+	"context"
+	"sync"
+)
+
+// MapReduceJob describes a map-reduce computation over elements of type T
+// producing intermediate (K, V) pairs and a result of type R per key.
+type MapReduceJob[T any, K comparable, V, R any] struct {
+	// Workers bounds the number of goroutines of each phase. Non-positive values default to GOMAXPROCS.
+	Workers int
+	// Map emits any number of key-value pairs for an element.
+	Map func(ctx context.Context, item T, emit func(K, V)) error
+	// Combine, if set, merges the values of a key emitted by a single worker before the reduce phase.
+	Combine func(key K, values []V) V
+	// Reduce produces the result for a key from all of its values.
+	Reduce func(ctx context.Context, key K, values []V) (R, error)
+}
+
+// MapReduce runs the job over the source slice and returns the reduced result per key.
+// The first error returned by Map or Reduce cancels the job and is returned.
+func MapReduce[S ~[]T, T any, K comparable, V, R any](ctx context.Context, source S, job MapReduceJob[T, K, V, R]) (map[K]R, error) {
+	var (
+		runCtx, cancel = context.WithCancel(ctx)
+		mu             sync.Mutex
+		firstErr       error
+	)
+
+	defer cancel()
+
+	var fail = func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	var parts = make([]map[K][]V, chunkCount(len(source), job.Workers, 0))
+	parallelChunks(len(source), job.Workers, 0, func(chunk, lo, hi int) {
+		var part = make(map[K][]V)
+		var emit = func(key K, value V) {
+			part[key] = append(part[key], value)
+		}
+
+		for _, item := range source[lo:hi] {
+			if runCtx.Err() != nil {
+				return
+			}
+
+			if err := job.Map(runCtx, item, emit); err != nil {
+				fail(err)
+				return
+			}
+		}
+
+		if job.Combine != nil {
+			for key, values := range part {
+				part[key] = []V{job.Combine(key, values)}
+			}
+		}
+
+		parts[chunk] = part
+	})
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var grouped = make(map[K][]V)
+	for _, part := range parts {
+		for key, values := range part {
+			grouped[key] = append(grouped[key], values...)
+		}
+	}
+
+	var (
+		keys    = MapKeys(grouped)
+		reduced = make([]R, len(keys))
+	)
+
+	parallelChunks(len(keys), job.Workers, 0, func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			if runCtx.Err() != nil {
+				return
+			}
+
+			var value, err = job.Reduce(runCtx, keys[i], grouped[keys[i]])
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			reduced[i] = value
+		}
+	})
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var result = make(map[K]R, len(keys))
+	for i, key := range keys {
+		result[key] = reduced[i]
+	}
+
+	return result, nil
+}
+
+// MapMapReduce runs the job over the key-value pairs of the source map and returns the reduced result per key.
+func MapMapReduce[K1 comparable, T any, K comparable, V, R any](ctx context.Context, source map[K1]T, job MapReduceJob[KV[K1, T], K, V, R]) (map[K]R, error) {
+	var pairs = MapToSlice(source, func(key K1, value T) KV[K1, T] {
+		return KV[K1, T]{Key: key, Value: value}
+	})
+
+	return MapReduce(ctx, pairs, job)
+}
+
+// MapReduceSorted runs the job over the source slice and returns the reduced results as a slice
+// sorted by key according to the less function.
+func MapReduceSorted[S ~[]T, T any, K comparable, V, R any](ctx context.Context, source S, job MapReduceJob[T, K, V, R], less func(l K, r K) bool) ([]KV[K, R], error) {
+	var result, err = MapReduce(ctx, source, job)
+	if err != nil {
+		return nil, err
+	}
+
+	var sorted = MapToSlice(result, func(key K, value R) KV[K, R] {
+		return KV[K, R]{Key: key, Value: value}
+	})
+
+	SortBy(sorted, func(l, r KV[K, R]) bool {
+		return less(l.Key, r.Key)
+	})
+
+	return sorted, nil
+}
@@ -0,0 +1,145 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func wordCount(workers int, combine bool) collection.MapReduceJob[string, string, int, int] {
+	job := collection.MapReduceJob[string, string, int, int]{
+		Workers: workers,
+		Map: func(_ context.Context, line string, emit func(string, int)) error {
+			for _, word := range strings.Fields(line) {
+				emit(word, 1)
+			}
+
+			return nil
+		},
+		Reduce: func(_ context.Context, _ string, counts []int) (int, error) {
+			return collection.Aggregate(counts, func(s, v int) int { return s + v }), nil
+		},
+	}
+
+	if combine {
+		job.Combine = func(_ string, counts []int) int {
+			return collection.Aggregate(counts, func(s, v int) int { return s + v })
+		}
+	}
+
+	return job
+}
+
+func TestMapReduce(t *testing.T) {
+	lines := []string{"a b a", "b c", "a", "", "c c"}
+	want := map[string]int{"a": 3, "b": 2, "c": 3}
+
+	cases := []struct {
+		name    string
+		workers int
+		combine bool
+	}{
+		{"single worker", 1, false},
+		{"many workers", 4, false},
+		{"combiner", 3, true},
+		{"default workers", 0, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := collection.MapReduce(context.Background(), lines, wordCount(tc.workers, tc.combine))
+
+			if err != nil || !maps.Equal(got, want) {
+				t.Errorf("MapReduce() = (%v, %v); want (%v, nil)", got, err, want)
+			}
+		})
+	}
+}
+
+func TestMapReduceErrors(t *testing.T) {
+	errMap := errors.New("map failed")
+	errReduce := errors.New("reduce failed")
+
+	failingMap := wordCount(2, false)
+	failingMap.Map = func(_ context.Context, line string, emit func(string, int)) error {
+		if line == "b" {
+			return errMap
+		}
+
+		emit(line, 1)
+		return nil
+	}
+
+	failingReduce := wordCount(2, false)
+	failingReduce.Reduce = func(context.Context, string, []int) (int, error) {
+		return 0, errReduce
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		job  collection.MapReduceJob[string, string, int, int]
+		want error
+	}{
+		{"map error", context.Background(), failingMap, errMap},
+		{"reduce error", context.Background(), failingReduce, errReduce},
+		{"canceled", ctx, wordCount(2, false), context.Canceled},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := collection.MapReduce(tc.ctx, []string{"a", "b", "c"}, tc.job)
+
+			if got != nil || !errors.Is(err, tc.want) {
+				t.Errorf("MapReduce() = (%v, %v); want (nil, %v)", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestMapMapReduce(t *testing.T) {
+	stock := map[string]int{"apple": 3, "avocado": 2, "banana": 5}
+
+	job := collection.MapReduceJob[collection.KV[string, int], byte, int, int]{
+		Map: func(_ context.Context, kv collection.KV[string, int], emit func(byte, int)) error {
+			emit(kv.Key[0], kv.Value)
+			return nil
+		},
+		Reduce: func(_ context.Context, _ byte, values []int) (int, error) {
+			return collection.Aggregate(values, func(s, v int) int { return s + v }), nil
+		},
+	}
+
+	got, err := collection.MapMapReduce(context.Background(), stock, job)
+	want := map[byte]int{'a': 5, 'b': 5}
+
+	if err != nil || !maps.Equal(got, want) {
+		t.Errorf("MapMapReduce() = (%v, %v); want (%v, nil)", got, err, want)
+	}
+}
+
+func TestMapReduceSorted(t *testing.T) {
+	got, err := collection.MapReduceSorted(context.Background(), []string{"b a", "c b"}, wordCount(2, true), func(l, r string) bool { return l < r })
+	want := []collection.KV[string, int]{{"a", 1}, {"b", 2}, {"c", 1}}
+
+	if err != nil || !slices.Equal(got, want) {
+		t.Errorf("MapReduceSorted() = (%v, %v); want (%v, nil)", got, err, want)
+	}
+}
+
+func ExampleMapReduceSorted() {
+	lines := []string{"to be or not to be"}
+
+	counts, _ := collection.MapReduceSorted(context.Background(), lines, wordCount(2, true), func(l, r string) bool { return l < r })
+	fmt.Println(counts)
+	// Output: [{be 2} {not 1} {or 1} {to 2}]
+}
@@ -0,0 +1,106 @@
+package collection
+
+import "golang.org/x/exp/constraints"
+
+type mergeCursor[T any] struct {
+	value  T
+	source int
+	index  int
+}
+
+func mergeLess[T any](less func(l T, r T) bool) func(l, r mergeCursor[T]) bool {
+	return func(l, r mergeCursor[T]) bool {
+		if less(l.value, r.value) {
+			return true
+		}
+
+		if less(r.value, l.value) {
+			return false
+		}
+
+		return l.source < r.source
+	}
+}
+
+// MergeSorted merges the pre-sorted source slices into a single slice sorted in ascending order.
+// If distinct is true, equal adjacent elements of the result are emitted only once.
+func MergeSorted[S ~[]T, T constraints.Ordered](distinct bool, sources ...S) S {
+	return MergeSortedBy(ascending[T], distinct, sources...)
+}
+
+// MergeSortedBy merges the source slices, each sorted according to the less function, into a single sorted slice
+// using a k-way heap merge. Equal elements keep the order of the sources they come from.
+// If distinct is true, equal adjacent elements of the result are emitted only once.
+func MergeSortedBy[S ~[]T, T any](less func(l T, r T) bool, distinct bool, sources ...S) S {
+	var (
+		size int
+		h    = NewHeap(mergeLess(less))
+	)
+
+	for i, source := range sources {
+		size += len(source)
+
+		if len(source) > 0 {
+			h.Push(mergeCursor[T]{value: source[0], source: i})
+		}
+	}
+
+	var result = make(S, 0, size)
+	for h.Len() > 0 {
+		var c, _ = h.Pop()
+
+		if !distinct || len(result) == 0 || less(result[len(result)-1], c.value) {
+			result = append(result, c.value)
+		}
+
+		if next := c.index + 1; next < len(sources[c.source]) {
+			h.Push(mergeCursor[T]{value: sources[c.source][next], source: c.source, index: next})
+		}
+	}
+
+	return result
+}
+
+// ChannelsMergeSorted merges the values of the pre-sorted source channels into one receive only channel
+// in ascending order. If distinct is true, equal adjacent values are emitted only once.
+func ChannelsMergeSorted[T constraints.Ordered](distinct bool, sources ...<-chan T) <-chan T {
+	return ChannelsMergeSortedBy(ascending[T], distinct, sources...)
+}
+
+// ChannelsMergeSortedBy merges the values of the source channels, each sorted according to the less function,
+// into one receive only channel preserving the order. A value is emitted only once every open source has one
+// pending, so a slow source holds back the output. If distinct is true, equal adjacent values are emitted only once.
+func ChannelsMergeSortedBy[T any](less func(l T, r T) bool, distinct bool, sources ...<-chan T) <-chan T {
+	var result = make(chan T)
+
+	go func() {
+		defer close(result)
+
+		var h = NewHeap(mergeLess(less))
+		for i, source := range sources {
+			if v, ok := <-source; ok {
+				h.Push(mergeCursor[T]{value: v, source: i})
+			}
+		}
+
+		var (
+			last    T
+			emitted bool
+		)
+
+		for h.Len() > 0 {
+			var c, _ = h.Pop()
+
+			if !distinct || !emitted || less(last, c.value) {
+				result <- c.value
+				last, emitted = c.value, true
+			}
+
+			if v, ok := <-sources[c.source]; ok {
+				h.Push(mergeCursor[T]{value: v, source: c.source})
+			}
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,97 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestMergeSorted(t *testing.T) {
+	cases := []struct {
+		name     string
+		sources  [][]int
+		distinct bool
+		want     []int
+	}{
+		{name: "no sources", sources: nil, want: []int{}},
+		{name: "single source", sources: [][]int{{1, 2, 3}}, want: []int{1, 2, 3}},
+		{name: "interleaved", sources: [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}, want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{name: "empty and uneven", sources: [][]int{{}, {1, 1, 10}, {2}}, want: []int{1, 1, 2, 10}},
+		{name: "distinct", sources: [][]int{{1, 1, 3}, {1, 2, 3}}, distinct: true, want: []int{1, 2, 3}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := collection.MergeSorted(tc.distinct, tc.sources...)
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("MergeSorted(%v, %v) = %v; want %v", tc.distinct, tc.sources, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMergeSortedByStable(t *testing.T) {
+	type event struct {
+		at    int
+		shard string
+	}
+
+	a := []event{{1, "a"}, {2, "a"}}
+	b := []event{{1, "b"}, {3, "b"}}
+
+	got := collection.MergeSortedBy(func(l, r event) bool { return l.at < r.at }, false, a, b)
+	want := []event{{1, "a"}, {1, "b"}, {2, "a"}, {3, "b"}}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSortedBy = %v; want %v", got, want)
+	}
+}
+
+func TestChannelsMergeSorted(t *testing.T) {
+	cases := []struct {
+		name     string
+		sources  [][]int
+		distinct bool
+		want     []int
+	}{
+		{name: "no sources", sources: nil, want: nil},
+		{name: "interleaved", sources: [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}, want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{name: "empty and uneven", sources: [][]int{{}, {1, 1, 10}, {2}}, want: []int{1, 1, 2, 10}},
+		{name: "distinct", sources: [][]int{{1, 1, 3}, {1, 2, 3}}, distinct: true, want: []int{1, 2, 3}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var channels []<-chan int
+			for _, source := range tc.sources {
+				ch := make(chan int, len(source))
+				for _, v := range source {
+					ch <- v
+				}
+				close(ch)
+
+				channels = append(channels, ch)
+			}
+
+			var got []int
+			for v := range collection.ChannelsMergeSorted(tc.distinct, channels...) {
+				got = append(got, v)
+			}
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("ChannelsMergeSorted(%v, %v) = %v; want %v", tc.distinct, tc.sources, got, tc.want)
+			}
+		})
+	}
+}
+
+func ExampleMergeSortedBy() {
+	desc := func(l, r string) bool { return l > r }
+
+	result := collection.MergeSortedBy(desc, true, []string{"c", "b", "a"}, []string{"d", "c"})
+	fmt.Println(result)
+	// Output: [d c b a]
+}
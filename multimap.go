@@ -0,0 +1,162 @@
+package collection
+
+import "slices"
+
+// MultiMap is a map associating every key with a bucket of values.
+// Buckets keep the insertion order of their values; set-valued buckets hold every value at most once.
+type MultiMap[K comparable, V comparable] struct {
+	m    map[K][]V
+	set  bool
+	size int
+	// members indexes the values of every bucket of a set-valued MultiMap.
+	members map[K]map[V]struct{}
+}
+
+// NewMultiMap creates an empty MultiMap whose buckets may hold duplicate values.
+func NewMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{m: make(map[K][]V)}
+}
+
+// NewSetMultiMap creates an empty MultiMap whose buckets hold every value at most once.
+func NewSetMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{m: make(map[K][]V), set: true, members: make(map[K]map[V]struct{})}
+}
+
+// Put adds the value to the bucket of the key and reports whether it was added.
+// A set-valued MultiMap does not add a value already present in the bucket.
+func (m *MultiMap[K, V]) Put(key K, value V) bool {
+	if m.set {
+		var members, ok = m.members[key]
+		if !ok {
+			members = make(map[V]struct{})
+			m.members[key] = members
+		}
+
+		if _, ok := members[value]; ok {
+			return false
+		}
+
+		members[value] = struct{}{}
+	}
+
+	m.m[key] = append(m.m[key], value)
+	m.size++
+
+	return true
+}
+
+// PutAll adds the values to the bucket of the key and returns the number of values added.
+func (m *MultiMap[K, V]) PutAll(key K, values ...V) int {
+	var added int
+	for _, v := range values {
+		if m.Put(key, v) {
+			added++
+		}
+	}
+
+	return added
+}
+
+// Get returns a copy of the bucket of the key.
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return slices.Clone(m.m[key])
+}
+
+// Remove removes the first occurrence of the value from the bucket of the key and reports whether it was found.
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	var (
+		bucket = m.m[key]
+		i      = slices.Index(bucket, value)
+	)
+
+	if i < 0 {
+		return false
+	}
+
+	if m.set {
+		delete(m.members[key], value)
+	}
+
+	if len(bucket) == 1 {
+		delete(m.m, key)
+		delete(m.members, key)
+	} else {
+		m.m[key] = slices.Delete(bucket, i, i+1)
+	}
+
+	m.size--
+
+	return true
+}
+
+// RemoveAll removes the bucket of the key and returns its values.
+func (m *MultiMap[K, V]) RemoveAll(key K) []V {
+	var bucket = m.m[key]
+
+	delete(m.m, key)
+	delete(m.members, key)
+	m.size -= len(bucket)
+
+	return bucket
+}
+
+// ContainsKey returns true if the key has a non-empty bucket.
+func (m *MultiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.m[key]
+	return ok
+}
+
+// ContainsEntry returns true if the bucket of the key holds the value.
+func (m *MultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	if m.set {
+		_, ok := m.members[key][value]
+		return ok
+	}
+
+	return slices.Contains(m.m[key], value)
+}
+
+// KeyCount returns the number of keys with a non-empty bucket.
+func (m *MultiMap[K, V]) KeyCount() int {
+	return len(m.m)
+}
+
+// ValueCount returns the number of values in all buckets.
+func (m *MultiMap[K, V]) ValueCount() int {
+	return m.size
+}
+
+// Keys returns a new slice containing all keys with a non-empty bucket. Order is not guaranteed.
+func (m *MultiMap[K, V]) Keys() []K {
+	return MapKeys(m.m)
+}
+
+// Range calls f sequentially for each key-value entry. The order of keys is not guaranteed.
+// If f returns false, range stops the iteration.
+func (m *MultiMap[K, V]) Range(f func(key K, value V) bool) {
+	for key, bucket := range m.m {
+		for _, value := range bucket {
+			if !f(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Entries returns a new slice containing all key-value entries. The order of keys is not guaranteed.
+func (m *MultiMap[K, V]) Entries() []KV[K, V] {
+	var result = make([]KV[K, V], 0, m.size)
+	m.Range(func(key K, value V) bool {
+		result = append(result, KV[K, V]{Key: key, Value: value})
+		return true
+	})
+
+	return result
+}
+
+// ToMap returns a new map of the keys to copies of their buckets.
+func (m *MultiMap[K, V]) ToMap() map[K][]V {
+	return MapTransformBy(m.m, func(bucket []V) []V {
+		return slices.Clone(bucket)
+	})
+}
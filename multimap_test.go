@@ -0,0 +1,118 @@
+package collection_test
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestMultiMap(t *testing.T) {
+	m := collection.NewMultiMap[string, int]()
+
+	m.Put("a", 1)
+	m.Put("a", 1)
+	if added := m.PutAll("b", 2, 3, 2); added != 3 {
+		t.Errorf("PutAll() = %v; want 3", added)
+	}
+
+	if m.KeyCount() != 2 || m.ValueCount() != 5 {
+		t.Errorf("KeyCount(), ValueCount() = %v, %v; want 2, 5", m.KeyCount(), m.ValueCount())
+	}
+
+	if !m.ContainsEntry("b", 3) || m.ContainsEntry("a", 3) || !m.ContainsKey("a") || m.ContainsKey("c") {
+		t.Errorf("ContainsEntry()/ContainsKey() mismatch")
+	}
+
+	if !m.Remove("b", 2) || m.Remove("b", 4) {
+		t.Errorf("Remove() mismatch")
+	}
+
+	if got := m.Get("b"); !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("Get(b) = %v; want %v", got, []int{3, 2})
+	}
+
+	if got := m.RemoveAll("a"); !slices.Equal(got, []int{1, 1}) || m.ContainsKey("a") || m.ValueCount() != 2 {
+		t.Errorf("RemoveAll(a) = %v; want %v", got, []int{1, 1})
+	}
+
+	m.Remove("b", 3)
+	m.Remove("b", 2)
+
+	if m.KeyCount() != 0 || m.ValueCount() != 0 {
+		t.Errorf("removing all values left KeyCount(), ValueCount() = %v, %v", m.KeyCount(), m.ValueCount())
+	}
+}
+
+func TestSetMultiMap(t *testing.T) {
+	m := collection.NewSetMultiMap[string, int]()
+
+	if !m.Put("a", 1) || m.Put("a", 1) {
+		t.Errorf("Put() of duplicate value into set bucket = true; want false")
+	}
+
+	if added := m.PutAll("a", 1, 2, 2); added != 1 || m.ValueCount() != 2 {
+		t.Errorf("PutAll() = %v, ValueCount() = %v; want 1, 2", added, m.ValueCount())
+	}
+
+	if !m.Remove("a", 1) || m.ContainsEntry("a", 1) || !m.Put("a", 1) {
+		t.Errorf("Put() after Remove() of the value = false; want true")
+	}
+
+	if got := m.Get("a"); !slices.Equal(got, []int{2, 1}) {
+		t.Errorf("Get() = %v; want [2 1]", got)
+	}
+
+	m.RemoveAll("a")
+	if m.ContainsEntry("a", 2) || !m.Put("a", 2) {
+		t.Errorf("Put() after RemoveAll() = false; want true")
+	}
+
+	values := make([]int, 100000)
+	for i := range values {
+		values[i] = i % 50000
+	}
+
+	if added := m.PutAll("b", values...); added != 50000 || len(m.Get("b")) != 50000 {
+		t.Errorf("PutAll() = %v, len(Get()) = %v; want 50000, 50000", added, len(m.Get("b")))
+	}
+}
+
+func TestMultiMapEntries(t *testing.T) {
+	m := collection.NewMultiMap[string, int]()
+	m.PutAll("a", 1, 2)
+	m.PutAll("b", 3)
+
+	keys := m.Keys()
+	sort.Strings(keys)
+
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("Keys() = %v; want %v", keys, []string{"a", "b"})
+	}
+
+	entries := m.Entries()
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Value < entries[j].Value })
+
+	want := []collection.KV[string, int]{{"a", 1}, {"a", 2}, {"b", 3}}
+	if !slices.Equal(entries, want) {
+		t.Errorf("Entries() = %v; want %v", entries, want)
+	}
+
+	copied := m.ToMap()
+	copied["a"][0] = 100
+
+	if got := m.Get("a"); got[0] != 1 {
+		t.Errorf("ToMap() shares buckets with the MultiMap")
+	}
+
+	var visited int
+	m.Range(func(string, int) bool {
+		visited++
+		return false
+	})
+
+	if visited != 1 {
+		t.Errorf("Range() visited %v entries after stop; want 1", visited)
+	}
+}
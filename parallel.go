@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"runtime"
+	"sync"
+)
+
+// splitChunks normalizes the worker count and chunk size for a range of size elements and returns them
+// with the resulting number of chunks. Non-positive workers default to GOMAXPROCS, non-positive chunkSize
+// spreads the range evenly between the workers.
+func splitChunks(size, workers, chunkSize int) (int, int, int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = (size + workers - 1) / workers
+	}
+
+	if chunkSize <= 0 {
+		return 0, 1, 0
+	}
+
+	var chunks = (size + chunkSize - 1) / chunkSize
+	if workers > chunks {
+		workers = chunks
+	}
+
+	return workers, chunkSize, chunks
+}
+
+// parallelChunks splits the range [0, size) into chunks as splitChunks does and calls fn for each chunk
+// from at most workers goroutines.
+func parallelChunks(size, workers, chunkSize int, fn func(chunk, lo, hi int)) {
+	var (
+		wg     sync.WaitGroup
+		chunks int
+	)
+
+	workers, chunkSize, chunks = splitChunks(size, workers, chunkSize)
+
+	var jobs = make(chan int, chunks)
+	for i := 0; i < chunks; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for chunk := range jobs {
+				var lo, hi = chunk * chunkSize, (chunk + 1) * chunkSize
+				if hi > size {
+					hi = size
+				}
+
+				fn(chunk, lo, hi)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func chunkCount(size, workers, chunkSize int) int {
+	var _, _, chunks = splitChunks(size, workers, chunkSize)
+	return chunks
+}
+
+// ParallelFilterBy returns a new slice with only the elements that satisfy the given filter function,
+// evaluating the filter on chunks of chunkSize elements from at most workers goroutines. The order is preserved.
+func ParallelFilterBy[S ~[]T, T any](source S, workers, chunkSize int, filter Filter[T]) S {
+	var parts = make([]S, chunkCount(len(source), workers, chunkSize))
+
+	parallelChunks(len(source), workers, chunkSize, func(chunk, lo, hi int) {
+		parts[chunk] = FilterBy(source[lo:hi], filter)
+	})
+
+	return Flatten(parts)
+}
+
+// ParallelGroupBy groups the elements of the slice by a key returned by the given key function,
+// evaluating the key function on chunks of chunkSize elements from at most workers goroutines.
+// The elements of every group keep their order.
+func ParallelGroupBy[S ~[]T, T any, K comparable](source S, workers, chunkSize int, keyFunc func(T) K) map[K]S {
+	var parts = make([]map[K]S, chunkCount(len(source), workers, chunkSize))
+
+	parallelChunks(len(source), workers, chunkSize, func(chunk, lo, hi int) {
+		parts[chunk] = GroupBy(source[lo:hi], keyFunc)
+	})
+
+	var result = make(map[K]S)
+	for _, part := range parts {
+		for key, values := range part {
+			result[key] = append(result[key], values...)
+		}
+	}
+
+	return result
+}
+
+// ParallelEach calls the given function for each element in the slice
+// on chunks of chunkSize elements from at most workers goroutines.
+func ParallelEach[S ~[]T, T any](source S, workers, chunkSize int, do func(T)) {
+	parallelChunks(len(source), workers, chunkSize, func(_, lo, hi int) {
+		Each(source[lo:hi], do)
+	})
+}
+
+// ParallelReduce aggregates chunks of chunkSize elements with the aggregator function from at most workers goroutines
+// and merges the per-chunk results in order with the combine function, which must be associative.
+func ParallelReduce[S ~[]T, T, K any](source S, workers, chunkSize int, aggregator func(K, T) K, combine func(K, K) K) K {
+	var parts = make([]K, chunkCount(len(source), workers, chunkSize))
+
+	parallelChunks(len(source), workers, chunkSize, func(chunk, lo, hi int) {
+		parts[chunk] = Aggregate(source[lo:hi], aggregator)
+	})
+
+	var result K
+	for i, part := range parts {
+		if i == 0 {
+			result = part
+			continue
+		}
+
+		result = combine(result, part)
+	}
+
+	return result
+}
@@ -0,0 +1,106 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func sequence(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i
+	}
+
+	return result
+}
+
+func TestParallelFilterBy(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	cases := []struct {
+		name      string
+		source    []int
+		workers   int
+		chunkSize int
+	}{
+		{"empty", nil, 4, 10},
+		{"defaults", sequence(1000), 0, 0},
+		{"uneven chunks", sequence(1001), 3, 7},
+		{"more workers than chunks", sequence(10), 16, 5},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := collection.ParallelFilterBy(tc.source, tc.workers, tc.chunkSize, isEven)
+			want := collection.FilterBy(tc.source, isEven)
+
+			if !slices.Equal(got, want) {
+				t.Errorf("ParallelFilterBy() = %v; want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParallelGroupBy(t *testing.T) {
+	mod3 := func(v int) int { return v % 3 }
+
+	source := sequence(100)
+	got := collection.ParallelGroupBy(source, 4, 7, mod3)
+	want := collection.GroupBy(source, mod3)
+
+	if !collection.MapEqualFunc(got, want, slices.Equal[[]int]) {
+		t.Errorf("ParallelGroupBy() = %v; want %v", got, want)
+	}
+}
+
+func TestParallelEach(t *testing.T) {
+	var sum atomic.Int64
+
+	collection.ParallelEach(sequence(101), 4, 10, func(v int) {
+		sum.Add(int64(v))
+	})
+
+	if sum.Load() != 5050 {
+		t.Errorf("ParallelEach() sum = %v; want 5050", sum.Load())
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	add := func(l, r int) int { return l + r }
+
+	cases := []struct {
+		name   string
+		source []int
+		want   int
+	}{
+		{"empty", nil, 0},
+		{"sum", sequence(101), 5050},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := collection.ParallelReduce(tc.source, 4, 9, add, add); got != tc.want {
+				t.Errorf("ParallelReduce() = %v; want %v", got, tc.want)
+			}
+		})
+	}
+
+	concat := func(l, r string) string { return l + r }
+	got := collection.ParallelReduce([]string{"a", "b", "c", "d", "e"}, 3, 2, concat, concat)
+
+	if got != "abcde" {
+		t.Errorf("ParallelReduce() = %v; want %v", got, "abcde")
+	}
+}
+
+func ExampleParallelReduce() {
+	add := func(l, r int) int { return l + r }
+
+	result := collection.ParallelReduce([]int{1, 2, 3, 4, 5}, 2, 2, add, add)
+	fmt.Println(result)
+	// Output: 15
+}
@@ -0,0 +1,134 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy describes how a failed transform is retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one. Values below 1 mean a single attempt.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after every attempt. Values below 1 default to 2.
+	Multiplier float64
+	// Jitter randomizes every delay by up to the given fraction of it, in the range [0, 1]. Larger values are treated as 1.
+	Jitter float64
+	// Retryable reports whether an error is worth another attempt. Nil means every error is retryable.
+	Retryable func(error) bool
+	// AttemptTimeout bounds the duration of a single attempt. Zero means no timeout.
+	AttemptTimeout time.Duration
+	// Clock is used to wait between attempts. Defaults to SystemClock.
+	Clock Clock
+}
+
+// RetryError is returned when a transform wrapped by WithRetry gives up.
+// It reports the number of attempts made and wraps the last error.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("after %d attempt(s): %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// backoff returns the delay before the attempt following the given one.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	var multiplier = p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	var delay = float64(p.InitialBackoff)
+	for i := 1; i < attempt && delay < math.MaxInt64; i++ {
+		delay *= multiplier
+
+		if p.MaxBackoff > 0 && delay >= float64(p.MaxBackoff) {
+			delay = float64(p.MaxBackoff)
+			break
+		}
+	}
+
+	if jitter := math.Min(p.Jitter, 1); jitter > 0 {
+		delay += delay * jitter * (2*rand.Float64() - 1)
+	}
+
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which does not fit into a Duration.
+	if delay >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	if delay < 0 {
+		return 0
+	}
+
+	return time.Duration(delay)
+}
+
+// WithRetry wraps the transform so that failed attempts are retried according to the policy.
+// The returned transform fits AsyncTryTransformBy; use BindContext to pass it to TryTransformBy or TryMapTransformBy.
+// Errors returned by the wrapped transform are *RetryError values carrying the attempt count.
+func WithRetry[T, K any](policy RetryPolicy, transform func(context.Context, T) (K, error)) func(context.Context, T) (K, error) {
+	var clock = policy.Clock
+	if clock == nil {
+		clock = SystemClock()
+	}
+
+	var attempt = func(ctx context.Context, item T) (K, error) {
+		if policy.AttemptTimeout <= 0 {
+			return transform(ctx, item)
+		}
+
+		var attemptCtx, cancel = context.WithTimeout(ctx, policy.AttemptTimeout)
+		defer cancel()
+
+		return transform(attemptCtx, item)
+	}
+
+	return func(ctx context.Context, item T) (K, error) {
+		for attempts := 1; ; attempts++ {
+			var value, err = attempt(ctx, item)
+			if err == nil {
+				return value, nil
+			}
+
+			if attempts >= policy.MaxAttempts || ctx.Err() != nil ||
+				(policy.Retryable != nil && !policy.Retryable(err)) {
+				return value, &RetryError{Attempts: attempts, Err: err}
+			}
+
+			if delay := policy.backoff(attempts); delay > 0 {
+				var timer = clock.NewTimer(delay)
+
+				select {
+				case <-timer.C():
+				case <-ctx.Done():
+					timer.Stop()
+					return value, &RetryError{Attempts: attempts, Err: err}
+				}
+			}
+		}
+	}
+}
+
+// BindContext binds the context to the transform, turning it into a transform accepted by TryTransformBy and TryMapTransformBy.
+func BindContext[T, K any](ctx context.Context, transform func(context.Context, T) (K, error)) func(T) (K, error) {
+	return func(item T) (K, error) {
+		return transform(ctx, item)
+	}
+}
@@ -0,0 +1,189 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+var errTransient = errors.New("transient")
+
+// failing returns a transform that fails the given number of times per item before succeeding.
+func failing(failures int) (func(context.Context, int) (string, error), *atomic.Int64) {
+	var calls atomic.Int64
+	var seen = collection.SyncMap[int, *atomic.Int64]{}
+
+	return func(_ context.Context, v int) (string, error) {
+		calls.Add(1)
+
+		counter, _ := seen.LoadOrStore(v, new(atomic.Int64))
+		if counter.Add(1) <= int64(failures) {
+			return "", errTransient
+		}
+
+		return strconv.Itoa(v), nil
+	}, &calls
+}
+
+func TestWithRetryBackoff(t *testing.T) {
+	clock := newFakeClock()
+	transform, calls := failing(2)
+
+	policy := collection.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, Clock: clock}
+
+	type result struct {
+		value []string
+		err   error
+	}
+
+	done := make(chan result)
+	go func() {
+		value, err := collection.TryTransformBy([]int{1}, collection.BindContext(context.Background(), collection.WithRetry(policy, transform)))
+		done <- result{value, err}
+	}()
+
+	clock.WaitCreated(1)
+	clock.Advance(time.Second)
+	clock.WaitCreated(2)
+	expectIdle(t, done)
+	clock.Advance(2 * time.Second)
+
+	got := receive(t, done)
+	if got.err != nil || !slices.Equal(got.value, []string{"1"}) {
+		t.Errorf("TryTransformBy() = (%v, %v); want ([1], nil)", got.value, got.err)
+	}
+
+	if calls.Load() != 3 {
+		t.Errorf("transform calls = %v; want 3", calls.Load())
+	}
+}
+
+func TestWithRetryBackoffBounds(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy collection.RetryPolicy
+	}{
+		{name: "uncapped growth", policy: collection.RetryPolicy{MaxAttempts: 50, InitialBackoff: time.Second}},
+		{name: "out of range jitter", policy: collection.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, Jitter: 1.5}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+			tc.policy.Clock = clock
+			transform, calls := failing(tc.policy.MaxAttempts)
+
+			done := make(chan error)
+			go func() {
+				_, err := collection.WithRetry(tc.policy, transform)(context.Background(), 1)
+				done <- err
+			}()
+
+			for i := 1; i < tc.policy.MaxAttempts; i++ {
+				clock.WaitCreated(i)
+				clock.Advance(time.Duration(math.MaxInt64))
+			}
+
+			var retryErr *collection.RetryError
+			if err := receive(t, done); !errors.As(err, &retryErr) || retryErr.Attempts != tc.policy.MaxAttempts {
+				t.Errorf("WithRetry() = %v; want RetryError after %v attempts", err, tc.policy.MaxAttempts)
+			}
+
+			if calls.Load() != int64(tc.policy.MaxAttempts) {
+				t.Errorf("transform calls = %v; want %v", calls.Load(), tc.policy.MaxAttempts)
+			}
+
+			clock.mu.Lock()
+			defer clock.mu.Unlock()
+
+			if want := tc.policy.MaxAttempts - 1; clock.created != want {
+				t.Errorf("timers created = %v; want %v", clock.created, want)
+			}
+		})
+	}
+}
+
+func TestWithRetryGivesUp(t *testing.T) {
+	errPermanent := errors.New("permanent")
+
+	cases := []struct {
+		name         string
+		policy       collection.RetryPolicy
+		transformErr error
+		wantAttempts int
+	}{
+		{"no policy", collection.RetryPolicy{}, errTransient, 1},
+		{"max attempts", collection.RetryPolicy{MaxAttempts: 4}, errTransient, 4},
+		{"not retryable", collection.RetryPolicy{MaxAttempts: 4, Retryable: func(err error) bool {
+			return errors.Is(err, errTransient)
+		}}, errPermanent, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			retry := collection.WithRetry(tc.policy, func(context.Context, int) (int, error) {
+				return 0, tc.transformErr
+			})
+
+			_, err := collection.TryMapTransformBy(map[string]int{"a": 1}, collection.BindContext(context.Background(), retry))
+
+			var retryErr *collection.RetryError
+			if !errors.As(err, &retryErr) || !errors.Is(err, tc.transformErr) || retryErr.Attempts != tc.wantAttempts {
+				t.Errorf("TryMapTransformBy() = %v; want %d attempt(s) of %v", err, tc.wantAttempts, tc.transformErr)
+			}
+		})
+	}
+}
+
+func TestWithRetryAttemptTimeout(t *testing.T) {
+	policy := collection.RetryPolicy{MaxAttempts: 2, AttemptTimeout: 10 * time.Millisecond}
+
+	var attempts atomic.Int64
+	retry := collection.WithRetry(policy, func(ctx context.Context, v int) (int, error) {
+		if attempts.Add(1) == 1 {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+
+		return v, nil
+	})
+
+	got, err := retry(context.Background(), 7)
+	if got != 7 || err != nil {
+		t.Errorf("retry() = (%v, %v); want (7, nil)", got, err)
+	}
+}
+
+func TestWithRetryAsyncTryTransformBy(t *testing.T) {
+	transform, _ := failing(1)
+
+	got, err := collection.AsyncTryTransformBy(context.Background(), []int{1, 2, 3}, collection.WithRetry(collection.RetryPolicy{MaxAttempts: 2}, transform))
+
+	slices.Sort(got)
+	if err != nil || !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("AsyncTryTransformBy() = (%v, %v); want ([1 2 3], nil)", got, err)
+	}
+}
+
+func TestWithRetryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	retry := collection.WithRetry(collection.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour}, func(context.Context, int) (int, error) {
+		cancel()
+		return 0, errTransient
+	})
+
+	_, err := retry(ctx, 1)
+
+	var retryErr *collection.RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 1 {
+		t.Errorf("retry() = %v; want a single attempt", err)
+	}
+}
@@ -0,0 +1,93 @@
+package collection
+
+// RingBuffer is a fixed-capacity buffer that overwrites its oldest element when full.
+type RingBuffer[T any] struct {
+	deque    Deque[T]
+	capacity int
+}
+
+// NewRingBuffer creates an empty RingBuffer holding up to capacity elements. It panics if capacity is not positive.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("collection: ring buffer capacity must be positive")
+	}
+
+	return &RingBuffer[T]{deque: Deque[T]{buf: make([]T, capacity)}, capacity: capacity}
+}
+
+// Len returns the number of elements in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.deque.Len()
+}
+
+// Cap returns the capacity of the buffer.
+func (r *RingBuffer[T]) Cap() int {
+	return r.capacity
+}
+
+// Full reports whether the buffer holds capacity elements.
+func (r *RingBuffer[T]) Full() bool {
+	return r.deque.Len() == r.capacity
+}
+
+// Push appends the value to the buffer. If the buffer is full, the oldest element is evicted and returned
+// with overwritten set to true.
+func (r *RingBuffer[T]) Push(v T) (evicted T, overwritten bool) {
+	if r.Full() {
+		evicted, overwritten = r.deque.PopFront()
+	}
+
+	r.deque.PushBack(v)
+
+	return evicted, overwritten
+}
+
+// Pop removes and returns the oldest element.
+// The ok result is false for an empty buffer.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	return r.deque.PopFront()
+}
+
+// Oldest returns the oldest element without removing it.
+// The ok result is false for an empty buffer.
+func (r *RingBuffer[T]) Oldest() (T, bool) {
+	return r.deque.Front()
+}
+
+// Newest returns the newest element without removing it.
+// The ok result is false for an empty buffer.
+func (r *RingBuffer[T]) Newest() (T, bool) {
+	return r.deque.Back()
+}
+
+// At returns the element at position i counted from the oldest. It panics if i is out of range.
+func (r *RingBuffer[T]) At(i int) T {
+	return r.deque.At(i)
+}
+
+// Range calls f sequentially for each element from the oldest to the newest.
+// If f returns false, range stops the iteration.
+func (r *RingBuffer[T]) Range(f func(i int, v T) bool) {
+	r.deque.Range(f)
+}
+
+// ToSlice returns a new slice with the elements from the oldest to the newest.
+func (r *RingBuffer[T]) ToSlice() []T {
+	return r.deque.ToSlice()
+}
+
+// Clone returns a shallow copy of the buffer.
+func (r *RingBuffer[T]) Clone() *RingBuffer[T] {
+	var clone = NewRingBuffer[T](r.capacity)
+	r.Range(func(_ int, v T) bool {
+		clone.Push(v)
+		return true
+	})
+
+	return clone
+}
+
+// Clear removes all elements from the buffer.
+func (r *RingBuffer[T]) Clear() {
+	r.deque.Clear()
+}
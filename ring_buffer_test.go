@@ -0,0 +1,84 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestRingBuffer(t *testing.T) {
+	r := collection.NewRingBuffer[int](3)
+
+	for i := 1; i <= 3; i++ {
+		if _, overwritten := r.Push(i); overwritten {
+			t.Errorf("Push(%d) overwritten = true; want false", i)
+		}
+	}
+
+	if !r.Full() || r.Len() != 3 || r.Cap() != 3 {
+		t.Errorf("Full(), Len(), Cap() = %v, %v, %v; want true, 3, 3", r.Full(), r.Len(), r.Cap())
+	}
+
+	if evicted, overwritten := r.Push(4); !overwritten || evicted != 1 {
+		t.Errorf("Push(4) = (%v, %v); want (1, true)", evicted, overwritten)
+	}
+
+	r.Push(5)
+
+	if got := r.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("ToSlice() = %v; want %v", got, []int{3, 4, 5})
+	}
+
+	if oldest, _ := r.Oldest(); oldest != 3 {
+		t.Errorf("Oldest() = %v; want 3", oldest)
+	}
+
+	if newest, _ := r.Newest(); newest != 5 {
+		t.Errorf("Newest() = %v; want 5", newest)
+	}
+
+	if got := r.At(1); got != 4 {
+		t.Errorf("At(1) = %v; want 4", got)
+	}
+
+	clone := r.Clone()
+	clone.Push(6)
+
+	if got := r.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Clone() changed the original buffer: %v", got)
+	}
+
+	if got := clone.ToSlice(); !slices.Equal(got, []int{4, 5, 6}) {
+		t.Errorf("clone ToSlice() = %v; want %v", got, []int{4, 5, 6})
+	}
+
+	if v, ok := r.Pop(); !ok || v != 3 || r.Len() != 2 {
+		t.Errorf("Pop() = (%v, %v), Len() = %v; want (3, true), 2", v, ok, r.Len())
+	}
+
+	var sum int
+	r.Range(func(_ int, v int) bool {
+		sum += v
+		return true
+	})
+
+	if sum != 9 {
+		t.Errorf("Range() sum = %v; want 9", sum)
+	}
+
+	r.Clear()
+	if _, ok := r.Pop(); ok {
+		t.Errorf("Pop() after Clear ok = true; want false")
+	}
+}
+
+func TestRingBufferInvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewRingBuffer(0) did not panic")
+		}
+	}()
+
+	collection.NewRingBuffer[int](0)
+}
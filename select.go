@@ -0,0 +1,197 @@
+package collection
+
+import "golang.org/x/exp/constraints"
+
+// topK collects the k largest values pushed into it according to less.
+type topK[T any] struct {
+	k    int
+	less func(l T, r T) bool
+	heap *Heap[T]
+}
+
+func newTopK[T any](k int, less func(l T, r T) bool) *topK[T] {
+	return &topK[T]{k: k, less: less, heap: NewHeap(less)}
+}
+
+func (t *topK[T]) push(v T) {
+	if t.heap.Len() < t.k {
+		t.heap.Push(v)
+		return
+	}
+
+	if top, _ := t.heap.Peek(); t.less(top, v) {
+		t.heap.Update(0, v)
+	}
+}
+
+// result returns the collected values from the largest to the smallest.
+func (t *topK[T]) result() []T {
+	var result = make([]T, t.heap.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = t.heap.Pop()
+	}
+
+	return result
+}
+
+// TopK returns the k largest elements of the slice in descending order.
+func TopK[S ~[]T, T constraints.Ordered](source S, k int) S {
+	return TopKBy(source, k, ascending[T])
+}
+
+// TopKBy returns the k largest elements of the slice according to the less function, from the largest to the smallest.
+// It runs in O(n log k) time and returns nil if k is not positive.
+func TopKBy[S ~[]T, T any](source S, k int, less func(l T, r T) bool) S {
+	if k <= 0 {
+		return nil
+	}
+
+	var top = newTopK(k, less)
+	for _, v := range source {
+		top.push(v)
+	}
+
+	return top.result()
+}
+
+// BottomK returns the k smallest elements of the slice in ascending order.
+func BottomK[S ~[]T, T constraints.Ordered](source S, k int) S {
+	return BottomKBy(source, k, ascending[T])
+}
+
+// BottomKBy returns the k smallest elements of the slice according to the less function, from the smallest to the largest.
+// It runs in O(n log k) time and returns nil if k is not positive.
+func BottomKBy[S ~[]T, T any](source S, k int, less func(l T, r T) bool) S {
+	return TopKBy(source, k, Less[T](less).Reversed())
+}
+
+// ChannelTopK returns the k largest values received from the source channel until it is closed, in descending order.
+func ChannelTopK[T constraints.Ordered](source <-chan T, k int) []T {
+	return ChannelTopKBy(source, k, ascending[T])
+}
+
+// ChannelTopKBy returns the k largest values received from the source channel until it is closed according to the less function,
+// from the largest to the smallest. Only k values are held in memory.
+func ChannelTopKBy[T any](source <-chan T, k int, less func(l T, r T) bool) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	var top = newTopK(k, less)
+	for v := range source {
+		top.push(v)
+	}
+
+	return top.result()
+}
+
+// SeqTopK returns the k largest values of the sequence in descending order.
+// The sequence has the shape of iter.Seq.
+func SeqTopK[T constraints.Ordered](seq func(yield func(T) bool), k int) []T {
+	return SeqTopKBy(seq, k, ascending[T])
+}
+
+// SeqTopKBy returns the k largest values of the sequence according to the less function, from the largest to the smallest.
+// The sequence has the shape of iter.Seq. Only k values are held in memory.
+func SeqTopKBy[T any](seq func(yield func(T) bool), k int, less func(l T, r T) bool) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	var top = newTopK(k, less)
+	seq(func(v T) bool {
+		top.push(v)
+		return true
+	})
+
+	return top.result()
+}
+
+// NthElement rearranges the slice in place so that the element at index n is the one that would be there
+// if the slice was sorted, no element before it is greater and no element after it is smaller.
+func NthElement[S ~[]T, T constraints.Ordered](source S, n int) {
+	NthElementBy(source, n, ascending[T])
+}
+
+// NthElementBy rearranges the slice in place so that the element at index n is the one that would be there
+// if the slice was sorted according to the less function, no element before it is greater and no element after it is smaller.
+// It uses quickselect and runs in O(n) average time. An out of range n leaves the slice unchanged.
+func NthElementBy[S ~[]T, T any](source S, n int, less func(l T, r T) bool) {
+	if n < 0 || n >= len(source) {
+		return
+	}
+
+	var lo, hi = 0, len(source) - 1
+	for lo < hi {
+		var lt, gt = partition(source, lo, hi, less)
+
+		switch {
+		case n < lt:
+			hi = lt - 1
+		case n > gt:
+			lo = gt + 1
+		default:
+			return
+		}
+	}
+}
+
+// partition partitions source[lo:hi+1] around a median of three pivot into elements less than,
+// equal to and greater than the pivot, and returns the bounds [lt, gt] of the equal ones.
+func partition[S ~[]T, T any](source S, lo, hi int, less func(l T, r T) bool) (int, int) {
+	var mid = lo + (hi-lo)/2
+
+	if less(source[mid], source[lo]) {
+		source[mid], source[lo] = source[lo], source[mid]
+	}
+
+	if less(source[hi], source[lo]) {
+		source[hi], source[lo] = source[lo], source[hi]
+	}
+
+	if less(source[hi], source[mid]) {
+		source[hi], source[mid] = source[mid], source[hi]
+	}
+
+	var (
+		pivot     = source[mid]
+		lt, i, gt = lo, lo, hi
+	)
+
+	for i <= gt {
+		switch {
+		case less(source[i], pivot):
+			source[i], source[lt] = source[lt], source[i]
+			lt++
+			i++
+		case less(pivot, source[i]):
+			source[i], source[gt] = source[gt], source[i]
+			gt--
+		default:
+			i++
+		}
+	}
+
+	return lt, gt
+}
+
+// PartialSort rearranges the slice in place so that its first k elements are the k smallest in ascending order.
+// The order of the remaining elements is unspecified.
+func PartialSort[S ~[]T, T constraints.Ordered](source S, k int) {
+	PartialSortBy(source, k, ascending[T])
+}
+
+// PartialSortBy rearranges the slice in place so that its first k elements are the k smallest according to the less function,
+// in sorted order. The order of the remaining elements is unspecified.
+func PartialSortBy[S ~[]T, T any](source S, k int, less func(l T, r T) bool) {
+	if k <= 0 {
+		return
+	}
+
+	if k < len(source) {
+		NthElementBy(source, k, less)
+		source = source[:k]
+	}
+
+	SortBy(source, less)
+}
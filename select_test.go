@@ -0,0 +1,136 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestTopK(t *testing.T) {
+	cases := []struct {
+		name   string
+		source []int
+		k      int
+		top    []int
+		bottom []int
+	}{
+		{"zero k", []int{1, 2, 3}, 0, nil, nil},
+		{"empty source", []int{}, 2, []int{}, []int{}},
+		{"k larger than source", []int{2, 3, 1}, 5, []int{3, 2, 1}, []int{1, 2, 3}},
+		{"with duplicates", []int{5, 1, 5, 3, 9, 1, 7}, 3, []int{9, 7, 5}, []int{1, 1, 3}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := collection.TopK(tc.source, tc.k); !slices.Equal(got, tc.top) {
+				t.Errorf("TopK(%v, %d) = %v; want %v", tc.source, tc.k, got, tc.top)
+			}
+
+			if got := collection.BottomK(tc.source, tc.k); !slices.Equal(got, tc.bottom) {
+				t.Errorf("BottomK(%v, %d) = %v; want %v", tc.source, tc.k, got, tc.bottom)
+			}
+		})
+	}
+}
+
+func TestTopKBy(t *testing.T) {
+	byLen := func(l, r string) bool { return len(l) < len(r) }
+	source := []string{"ccc", "a", "dddd", "bb"}
+
+	if got := collection.TopKBy(source, 2, byLen); !slices.Equal(got, []string{"dddd", "ccc"}) {
+		t.Errorf("TopKBy() = %v; want %v", got, []string{"dddd", "ccc"})
+	}
+
+	if got := collection.BottomKBy(source, 2, byLen); !slices.Equal(got, []string{"a", "bb"}) {
+		t.Errorf("BottomKBy() = %v; want %v", got, []string{"a", "bb"})
+	}
+}
+
+func TestStreamingTopK(t *testing.T) {
+	source := randomInts(1000)
+	want := collection.TopK(source, 5)
+
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+
+		for _, v := range source {
+			ch <- v
+		}
+	}()
+
+	if got := collection.ChannelTopK(ch, 5); !slices.Equal(got, want) {
+		t.Errorf("ChannelTopK() = %v; want %v", got, want)
+	}
+
+	seq := func(yield func(int) bool) {
+		for _, v := range source {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	if got := collection.SeqTopK(seq, 5); !slices.Equal(got, want) {
+		t.Errorf("SeqTopK() = %v; want %v", got, want)
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	cases := []struct {
+		name   string
+		source []int
+	}{
+		{"random", randomInts(1000)},
+		{"all equal", make([]int, 100)},
+		{"sorted", sequence(100)},
+		{"single", []int{1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted := slices.Clone(tc.source)
+			slices.Sort(sorted)
+
+			for _, n := range []int{0, len(tc.source) / 3, len(tc.source) - 1} {
+				got := slices.Clone(tc.source)
+				collection.NthElement(got, n)
+
+				if got[n] != sorted[n] {
+					t.Fatalf("NthElement(%d) = %v; want %v", n, got[n], sorted[n])
+				}
+
+				for i, v := range got {
+					if (i < n && v > got[n]) || (i > n && v < got[n]) {
+						t.Fatalf("NthElement(%d) is not partitioned at index %d", n, i)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	source := randomInts(100)
+	sorted := slices.Clone(source)
+	slices.Sort(sorted)
+
+	for _, k := range []int{0, 1, 10, 100, 200} {
+		got := slices.Clone(source)
+		collection.PartialSort(got, k)
+
+		n := collection.Min(k, len(got))
+		if !slices.Equal(got[:n], sorted[:n]) {
+			t.Errorf("PartialSort(%d) prefix = %v; want %v", k, got[:n], sorted[:n])
+		}
+	}
+}
+
+func ExampleTopK() {
+	orders := []int{120, 80, 450, 300, 99}
+
+	fmt.Println(collection.TopK(orders, 3))
+	// Output: [450 300 120]
+}
@@ -3,10 +3,16 @@ package collection
 import (
 	"slices"
 	"sort"
+	"sync"
 
 	"golang.org/x/exp/constraints"
 )
 
+// ascending is the less function of the natural ascending order.
+func ascending[T constraints.Ordered](l T, r T) bool {
+	return l < r
+}
+
 // Sort sorts the source slice of type T in ascending order.
 func Sort[S ~[]T, T constraints.Ordered](source S) {
 	slices.Sort(source)
@@ -19,9 +25,172 @@ func SortBy[S ~[]T, T any](source S, less func(l T, r T) bool) {
 	})
 }
 
+// SortStableBy sorts the source slice of type T according to the less function provided,
+// keeping the original order of equal elements.
+func SortStableBy[S ~[]T, T any](source S, less func(l T, r T) bool) {
+	sort.SliceStable(source, func(i, j int) bool {
+		return less(source[i], source[j])
+	})
+}
+
+// SortByKey stably sorts the source slice of type T in ascending order of the keys returned by keyFunc.
+// The key of every element is extracted only once.
+func SortByKey[S ~[]T, T any, K constraints.Ordered](source S, keyFunc func(T) K) {
+	var decorated = TransformBy(source, func(v T) Pair[K, T] {
+		return Pair[K, T]{First: keyFunc(v), Second: v}
+	})
+
+	SortStableBy(decorated, func(l, r Pair[K, T]) bool {
+		return l.First < r.First
+	})
+
+	for i, p := range decorated {
+		source[i] = p.Second
+	}
+}
+
+// Less is a less function that can be composed into multi-key orderings and passed to any sort function of the package.
+type Less[T any] func(l T, r T) bool
+
+// OrderBy returns a Less ordering elements by the key returned by keyFunc in ascending order.
+func OrderBy[T any, K constraints.Ordered](keyFunc func(T) K) Less[T] {
+	return func(l, r T) bool {
+		return keyFunc(l) < keyFunc(r)
+	}
+}
+
+// OrderByDescending returns a Less ordering elements by the key returned by keyFunc in descending order.
+func OrderByDescending[T any, K constraints.Ordered](keyFunc func(T) K) Less[T] {
+	return OrderBy(keyFunc).Reversed()
+}
+
+// ThenBy returns a Less that orders elements equal according to less by next.
+func (less Less[T]) ThenBy(next Less[T]) Less[T] {
+	return func(l, r T) bool {
+		if less(l, r) {
+			return true
+		}
+
+		if less(r, l) {
+			return false
+		}
+
+		return next(l, r)
+	}
+}
+
+// ThenByDescending returns a Less that orders elements equal according to less by next in reverse.
+func (less Less[T]) ThenByDescending(next Less[T]) Less[T] {
+	return less.ThenBy(next.Reversed())
+}
+
+// Reversed returns a Less with the opposite order.
+func (less Less[T]) Reversed() Less[T] {
+	return func(l, r T) bool {
+		return less(r, l)
+	}
+}
+
 // Reverse reverses the order of the elements in the source slice of type T.
 func Reverse[S ~[]T, T any](source S) {
 	for i, j := 0, len(source)-1; i < j; i, j = i+1, j-1 {
 		source[i], source[j] = source[j], source[i]
 	}
 }
+
+// defaultParallelSortThreshold is the slice length below which the parallel sorts fall back to a sequential sort
+// when no threshold is given.
+const defaultParallelSortThreshold = 1 << 13
+
+// ParallelSort sorts the source slice of type T in ascending order using a parallel merge sort
+// with at most workers goroutines. Non-positive workers default to GOMAXPROCS.
+// Slices shorter than threshold are sorted sequentially; a non-positive threshold selects a default.
+func ParallelSort[S ~[]T, T constraints.Ordered](source S, workers, threshold int) {
+	parallelMergeSort(source, workers, threshold, slices.Sort[S], ascending[T])
+}
+
+// ParallelSortBy sorts the source slice of type T according to the less function provided using a parallel merge sort
+// with at most workers goroutines. Non-positive workers default to GOMAXPROCS.
+// Slices shorter than threshold are sorted sequentially; a non-positive threshold selects a default.
+func ParallelSortBy[S ~[]T, T any](source S, workers, threshold int, less func(l T, r T) bool) {
+	parallelMergeSort(source, workers, threshold, func(s S) { SortBy(s, less) }, less)
+}
+
+func parallelMergeSort[S ~[]T, T any](source S, workers, threshold int, sort func(S), less func(l T, r T) bool) {
+	if threshold <= 0 {
+		threshold = defaultParallelSortThreshold
+	}
+
+	var chunkSize, chunks int
+	workers, chunkSize, chunks = splitChunks(len(source), workers, 0)
+
+	if len(source) < threshold || chunks <= 1 {
+		sort(source)
+		return
+	}
+
+	parallelChunks(len(source), workers, chunkSize, func(_, lo, hi int) {
+		sort(source[lo:hi])
+	})
+
+	var bounds = make([]int, 0, chunks+1)
+	for lo := 0; lo < len(source); lo += chunkSize {
+		bounds = append(bounds, lo)
+	}
+	bounds = append(bounds, len(source))
+
+	var (
+		src = source
+		dst = make(S, len(source))
+	)
+
+	for len(bounds) > 2 {
+		var (
+			next = make([]int, 0, len(bounds)/2+1)
+			wg   sync.WaitGroup
+		)
+
+		for i := 0; i+1 < len(bounds); i += 2 {
+			next = append(next, bounds[i])
+
+			if i+2 >= len(bounds) {
+				copy(dst[bounds[i]:bounds[i+1]], src[bounds[i]:bounds[i+1]])
+				continue
+			}
+
+			wg.Add(1)
+			go func(lo, mid, hi int) {
+				defer wg.Done()
+
+				mergeRuns(dst[lo:hi], src[lo:mid], src[mid:hi], less)
+			}(bounds[i], bounds[i+1], bounds[i+2])
+		}
+
+		wg.Wait()
+
+		bounds = append(next, len(source))
+		src, dst = dst, src
+	}
+
+	if &src[0] != &source[0] {
+		copy(source, src)
+	}
+}
+
+// mergeRuns merges the sorted runs left and right into dst, taking from left first on ties.
+func mergeRuns[S ~[]T, T any](dst, left, right S, less func(l T, r T) bool) {
+	var i, j, k int
+	for i < len(left) && j < len(right) {
+		if less(right[j], left[i]) {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
@@ -1,6 +1,7 @@
 package collection_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"slices"
@@ -64,3 +65,167 @@ func TestReverse(t *testing.T) {
 		}
 	}
 }
+
+func TestSortStableBy(t *testing.T) {
+	source := []string{"bb", "a", "cc", "d", "aa"}
+	collection.SortStableBy(source, func(l, r string) bool { return len(l) < len(r) })
+
+	want := []string{"a", "d", "bb", "cc", "aa"}
+	if !slices.Equal(source, want) {
+		t.Errorf("SortStableBy() = %v, want %v", source, want)
+	}
+}
+
+func TestSortByKey(t *testing.T) {
+	calls := 0
+	source := []string{"ccc", "a", "bb", "dd"}
+
+	collection.SortByKey(source, func(s string) int {
+		calls++
+		return len(s)
+	})
+
+	want := []string{"a", "bb", "dd", "ccc"}
+	if !slices.Equal(source, want) {
+		t.Errorf("SortByKey() = %v, want %v", source, want)
+	}
+
+	if calls != len(source) {
+		t.Errorf("SortByKey() key calls = %v, want %v", calls, len(source))
+	}
+}
+
+func TestLess(t *testing.T) {
+	type order struct {
+		date string
+		user string
+	}
+
+	byDate := collection.OrderBy(func(o order) string { return o.date })
+	byUser := collection.OrderBy(func(o order) string { return o.user })
+
+	source := []order{{"02", "bob"}, {"01", "eve"}, {"02", "amy"}, {"01", "dan"}}
+
+	cases := []struct {
+		name string
+		less collection.Less[order]
+		want []order
+	}{
+		{"then by", byDate.ThenBy(byUser), []order{{"01", "dan"}, {"01", "eve"}, {"02", "amy"}, {"02", "bob"}}},
+		{"then by descending", byDate.ThenByDescending(byUser), []order{{"01", "eve"}, {"01", "dan"}, {"02", "bob"}, {"02", "amy"}}},
+		{"reversed", byDate.ThenBy(byUser).Reversed(), []order{{"02", "bob"}, {"02", "amy"}, {"01", "eve"}, {"01", "dan"}}},
+		{"descending", collection.OrderByDescending(func(o order) string { return o.user }), []order{{"01", "eve"}, {"01", "dan"}, {"02", "bob"}, {"02", "amy"}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := slices.Clone(source)
+			collection.SortBy(got, tc.less)
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("SortBy() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func randomInts(n int) []int {
+	r := rand.New(rand.NewSource(int64(n)))
+
+	result := make([]int, n)
+	for i := range result {
+		result[i] = r.Intn(n)
+	}
+
+	return result
+}
+
+func TestParallelSort(t *testing.T) {
+	cases := []struct {
+		name      string
+		size      int
+		workers   int
+		threshold int
+	}{
+		{"empty", 0, 4, 1},
+		{"below threshold", 100, 4, 1000},
+		{"single worker", 1000, 1, 1},
+		{"even runs", 1024, 4, 1},
+		{"odd run count", 1001, 3, 1},
+		{"defaults", 50000, 0, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := randomInts(tc.size)
+			want := slices.Clone(got)
+			slices.Sort(want)
+
+			collection.ParallelSort(got, tc.workers, tc.threshold)
+
+			if !slices.Equal(got, want) {
+				t.Errorf("ParallelSort(%d elements) is not sorted", tc.size)
+			}
+		})
+	}
+}
+
+func TestParallelSortBy(t *testing.T) {
+	type entry struct {
+		key   int
+		index int
+	}
+
+	source := collection.TransformBy(randomInts(1000), func(v int) entry { return entry{key: v % 10} })
+	for i := range source {
+		source[i].index = i
+	}
+
+	collection.ParallelSortBy(source, 4, 1, func(l, r entry) bool { return l.key > r.key })
+
+	if !slices.IsSortedFunc(source, func(l, r entry) int { return r.key - l.key }) {
+		t.Errorf("ParallelSortBy() is not sorted")
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	source := randomInts(1 << 20)
+	buffer := make([]int, len(source))
+
+	for i := 0; i < b.N; i++ {
+		copy(buffer, source)
+		collection.Sort(buffer)
+	}
+}
+
+func BenchmarkParallelSort(b *testing.B) {
+	source := randomInts(1 << 20)
+	buffer := make([]int, len(source))
+
+	for i := 0; i < b.N; i++ {
+		copy(buffer, source)
+		collection.ParallelSort(buffer, 0, 0)
+	}
+}
+
+func BenchmarkSortBy(b *testing.B) {
+	source := randomInts(1 << 20)
+	buffer := make([]int, len(source))
+	less := func(l, r int) bool { return l < r }
+
+	for i := 0; i < b.N; i++ {
+		copy(buffer, source)
+		collection.SortBy(buffer, less)
+	}
+}
+
+func BenchmarkParallelSortBy(b *testing.B) {
+	source := randomInts(1 << 20)
+	buffer := make([]int, len(source))
+	less := func(l, r int) bool { return l < r }
+
+	for i := 0; i < b.N; i++ {
+		copy(buffer, source)
+		collection.ParallelSortBy(buffer, 0, 0, less)
+	}
+}
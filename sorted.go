@@ -0,0 +1,180 @@
+package collection
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// IsSorted reports whether the source slice is sorted in ascending order.
+func IsSorted[S ~[]T, T constraints.Ordered](source S) bool {
+	return slices.IsSorted(source)
+}
+
+// IsSortedBy reports whether the source slice is sorted according to the less function.
+func IsSortedBy[S ~[]T, T any](source S, less func(l T, r T) bool) bool {
+	for i := 1; i < len(source); i++ {
+		if less(source[i], source[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LowerBound returns the index of the first element of the sorted slice that is not less than target.
+func LowerBound[S ~[]T, T constraints.Ordered](source S, target T) int {
+	return LowerBoundBy(source, target, ascending[T])
+}
+
+// LowerBoundBy returns the index of the first element of the slice, sorted according to the less function,
+// that is not less than target.
+func LowerBoundBy[S ~[]T, T any](source S, target T, less func(l T, r T) bool) int {
+	var lo, hi = 0, len(source)
+	for lo < hi {
+		var mid = int(uint(lo+hi) >> 1)
+		if less(source[mid], target) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
+
+// UpperBound returns the index of the first element of the sorted slice that is greater than target.
+func UpperBound[S ~[]T, T constraints.Ordered](source S, target T) int {
+	return UpperBoundBy(source, target, ascending[T])
+}
+
+// UpperBoundBy returns the index of the first element of the slice, sorted according to the less function,
+// that is greater than target.
+func UpperBoundBy[S ~[]T, T any](source S, target T, less func(l T, r T) bool) int {
+	var lo, hi = 0, len(source)
+	for lo < hi {
+		var mid = int(uint(lo+hi) >> 1)
+		if less(target, source[mid]) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return lo
+}
+
+// BinarySearch searches for target in the sorted slice and returns the index of its first occurrence,
+// or the index where it would be inserted, and whether it was found.
+func BinarySearch[S ~[]T, T constraints.Ordered](source S, target T) (int, bool) {
+	return BinarySearchBy(source, target, ascending[T])
+}
+
+// BinarySearchBy searches for target in the slice sorted according to the less function and returns the index
+// of its first occurrence, or the index where it would be inserted, and whether it was found.
+func BinarySearchBy[S ~[]T, T any](source S, target T, less func(l T, r T) bool) (int, bool) {
+	var i = LowerBoundBy(source, target, less)
+	return i, i < len(source) && !less(target, source[i])
+}
+
+// InsertSorted inserts value into the sorted slice after any equal elements and returns the modified slice.
+func InsertSorted[S ~[]T, T constraints.Ordered](source S, value T) S {
+	return InsertSortedBy(source, value, ascending[T])
+}
+
+// InsertSortedBy inserts value into the slice sorted according to the less function after any equal elements
+// and returns the modified slice.
+func InsertSortedBy[S ~[]T, T any](source S, value T, less func(l T, r T) bool) S {
+	return slices.Insert(source, UpperBoundBy(source, value, less), value)
+}
+
+// RemoveSorted removes the first occurrence of value from the sorted slice and returns the modified slice
+// and whether value was found.
+func RemoveSorted[S ~[]T, T constraints.Ordered](source S, value T) (S, bool) {
+	return RemoveSortedBy(source, value, ascending[T])
+}
+
+// RemoveSortedBy removes the first occurrence of value from the slice sorted according to the less function
+// and returns the modified slice and whether value was found.
+func RemoveSortedBy[S ~[]T, T any](source S, value T, less func(l T, r T) bool) (S, bool) {
+	var i, found = BinarySearchBy(source, value, less)
+	if !found {
+		return source, false
+	}
+
+	return slices.Delete(source, i, i+1), true
+}
+
+// SortedUnion finds a set union of the sorted slices a and b in linear time
+// (unique values that are in a or in b), in ascending order.
+func SortedUnion[S ~[]T, T constraints.Ordered](a S, b S) S {
+	return SortedUnionBy(a, b, ascending[T])
+}
+
+// SortedUnionBy finds a set union of the slices a and b sorted according to the less function in linear time
+// (unique values that are in a or in b), in sorted order.
+func SortedUnionBy[S ~[]T, T any](a S, b S, less func(l T, r T) bool) S {
+	return MergeSortedBy(less, true, a, b)
+}
+
+// SortedIntersection finds a set intersection of the sorted slices a and b in linear time
+// (unique values that are in a and in b), in ascending order.
+func SortedIntersection[S ~[]T, T constraints.Ordered](a S, b S) S {
+	return SortedIntersectionBy(a, b, ascending[T])
+}
+
+// SortedIntersectionBy finds a set intersection of the slices a and b sorted according to the less function in linear time
+// (unique values that are in a and in b), in sorted order.
+func SortedIntersectionBy[S ~[]T, T any](a S, b S, less func(l T, r T) bool) S {
+	var (
+		result = make(S, 0)
+		i, j   int
+	)
+
+	for i < len(a) && j < len(b) {
+		switch {
+		case less(a[i], b[j]):
+			i++
+		case less(b[j], a[i]):
+			j++
+		default:
+			if len(result) == 0 || less(result[len(result)-1], a[i]) {
+				result = append(result, a[i])
+			}
+
+			i++
+			j++
+		}
+	}
+
+	return result
+}
+
+// SortedDifference finds a set difference between the sorted slices a and b in linear time
+// (values that are in a but not in b or a-b), in ascending order.
+func SortedDifference[S ~[]T, T constraints.Ordered](a S, b S) S {
+	return SortedDifferenceBy(a, b, ascending[T])
+}
+
+// SortedDifferenceBy finds a set difference between the slices a and b sorted according to the less function in linear time
+// (values that are in a but not in b or a-b), in sorted order.
+func SortedDifferenceBy[S ~[]T, T any](a S, b S, less func(l T, r T) bool) S {
+	var (
+		result = make(S, 0, len(a))
+		j      int
+	)
+
+	for _, v := range a {
+		for j < len(b) && less(b[j], v) {
+			j++
+		}
+
+		if j < len(b) && !less(v, b[j]) {
+			continue
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
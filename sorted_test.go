@@ -0,0 +1,135 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestIsSorted(t *testing.T) {
+	cases := []struct {
+		source []int
+		want   bool
+	}{
+		{nil, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 2, 3}, true},
+		{[]int{1, 3, 2}, false},
+	}
+
+	for _, tc := range cases {
+		if got := collection.IsSorted(tc.source); got != tc.want {
+			t.Errorf("IsSorted(%v) = %v; want %v", tc.source, got, tc.want)
+		}
+
+		if got := collection.IsSortedBy(tc.source, func(l, r int) bool { return l < r }); got != tc.want {
+			t.Errorf("IsSortedBy(%v) = %v; want %v", tc.source, got, tc.want)
+		}
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	source := []int{1, 3, 3, 3, 5, 8}
+
+	cases := []struct {
+		target    int
+		wantIndex int
+		wantFound bool
+		wantLower int
+		wantUpper int
+	}{
+		{0, 0, false, 0, 0},
+		{1, 0, true, 0, 1},
+		{3, 1, true, 1, 4},
+		{4, 4, false, 4, 4},
+		{8, 5, true, 5, 6},
+		{9, 6, false, 6, 6},
+	}
+
+	for _, tc := range cases {
+		if i, found := collection.BinarySearch(source, tc.target); i != tc.wantIndex || found != tc.wantFound {
+			t.Errorf("BinarySearch(%v) = (%v, %v); want (%v, %v)", tc.target, i, found, tc.wantIndex, tc.wantFound)
+		}
+
+		if got := collection.LowerBound(source, tc.target); got != tc.wantLower {
+			t.Errorf("LowerBound(%v) = %v; want %v", tc.target, got, tc.wantLower)
+		}
+
+		if got := collection.UpperBound(source, tc.target); got != tc.wantUpper {
+			t.Errorf("UpperBound(%v) = %v; want %v", tc.target, got, tc.wantUpper)
+		}
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	source := []string{"dddd", "ccc", "bb", "a"}
+	desc := func(l, r string) bool { return len(l) > len(r) }
+
+	if i, found := collection.BinarySearchBy(source, "xx", desc); i != 2 || !found {
+		t.Errorf("BinarySearchBy() = (%v, %v); want (2, true)", i, found)
+	}
+}
+
+func TestInsertRemoveSorted(t *testing.T) {
+	var source []int
+	for _, v := range []int{5, 1, 3, 3, 9} {
+		source = collection.InsertSorted(source, v)
+	}
+
+	if want := []int{1, 3, 3, 5, 9}; !slices.Equal(source, want) {
+		t.Errorf("InsertSorted() = %v; want %v", source, want)
+	}
+
+	source, removed := collection.RemoveSorted(source, 3)
+	if want := []int{1, 3, 5, 9}; !removed || !slices.Equal(source, want) {
+		t.Errorf("RemoveSorted(3) = (%v, %v); want (%v, true)", source, removed, want)
+	}
+
+	source, removed = collection.RemoveSorted(source, 4)
+	if want := []int{1, 3, 5, 9}; removed || !slices.Equal(source, want) {
+		t.Errorf("RemoveSorted(4) = (%v, %v); want (%v, false)", source, removed, want)
+	}
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	cases := []struct {
+		name         string
+		a, b         []int
+		union        []int
+		intersection []int
+		difference   []int
+	}{
+		{"empty", nil, nil, []int{}, []int{}, []int{}},
+		{"disjoint", []int{1, 3}, []int{2, 4}, []int{1, 2, 3, 4}, []int{}, []int{1, 3}},
+		{"overlapping", []int{1, 2, 2, 3, 5}, []int{2, 3, 4}, []int{1, 2, 3, 4, 5}, []int{2, 3}, []int{1, 5}},
+		{"duplicates kept in difference", []int{1, 1, 4}, []int{4}, []int{1, 4}, []int{4}, []int{1, 1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := collection.SortedUnion(tc.a, tc.b); !slices.Equal(got, tc.union) {
+				t.Errorf("SortedUnion(%v, %v) = %v; want %v", tc.a, tc.b, got, tc.union)
+			}
+
+			if got := collection.SortedIntersection(tc.a, tc.b); !slices.Equal(got, tc.intersection) {
+				t.Errorf("SortedIntersection(%v, %v) = %v; want %v", tc.a, tc.b, got, tc.intersection)
+			}
+
+			if got := collection.SortedDifference(tc.a, tc.b); !slices.Equal(got, tc.difference) {
+				t.Errorf("SortedDifference(%v, %v) = %v; want %v", tc.a, tc.b, got, tc.difference)
+			}
+		})
+	}
+}
+
+func ExampleBinarySearch() {
+	ids := []int{10, 20, 30, 40}
+
+	fmt.Println(collection.BinarySearch(ids, 30))
+	fmt.Println(collection.BinarySearch(ids, 35))
+	// Output:
+	// 2 true
+	// 3 false
+}
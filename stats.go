@@ -0,0 +1,203 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrOverflow is returned by CheckedSum when the sum does not fit into the element type.
+var ErrOverflow = errors.New("collection: integer overflow")
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Interpolation defines how a quantile falling between two elements is computed.
+type Interpolation int
+
+const (
+	// InterpolationLinear interpolates linearly between the two surrounding elements.
+	InterpolationLinear Interpolation = iota
+	// InterpolationLower takes the lower of the two surrounding elements.
+	InterpolationLower
+	// InterpolationHigher takes the higher of the two surrounding elements.
+	InterpolationHigher
+	// InterpolationNearest takes the nearest of the two surrounding elements, rounding half to even.
+	InterpolationNearest
+	// InterpolationMidpoint takes the mean of the two surrounding elements.
+	InterpolationMidpoint
+)
+
+// Sum returns the sum of the elements of the slice. Integer sums wrap around on overflow, see CheckedSum.
+func Sum[S ~[]T, T Number](source S) T {
+	var result T
+	for _, v := range source {
+		result += v
+	}
+
+	return result
+}
+
+// SumBy returns the sum of the values returned by the given value function for every element of the slice.
+func SumBy[S ~[]T, T any, K Number](source S, valueFunc func(T) K) K {
+	var result K
+	for _, v := range source {
+		result += valueFunc(v)
+	}
+
+	return result
+}
+
+// CheckedSum returns the sum of the elements of the slice or ErrOverflow if it does not fit into T.
+func CheckedSum[S ~[]T, T constraints.Integer](source S) (T, error) {
+	var result T
+	for _, v := range source {
+		var next = result + v
+		if (v > 0 && next < result) || (v < 0 && next > result) {
+			return result, ErrOverflow
+		}
+
+		result = next
+	}
+
+	return result, nil
+}
+
+// Mean returns the arithmetic mean of the elements of the slice. The ok result is false for an empty slice.
+func Mean[S ~[]T, T Number](source S) (result float64, ok bool) {
+	return AverageBy(source, func(v T) T { return v })
+}
+
+// AverageBy returns the arithmetic mean of the values returned by the given value function for every element of the slice.
+// The ok result is false for an empty slice.
+func AverageBy[S ~[]T, T any, K Number](source S, valueFunc func(T) K) (result float64, ok bool) {
+	if len(source) == 0 {
+		return 0, false
+	}
+
+	for _, v := range source {
+		result += float64(valueFunc(v))
+	}
+
+	return result / float64(len(source)), true
+}
+
+// Median returns the median of the elements of the slice, averaging the two middle elements for even lengths.
+// The ok result is false for an empty slice.
+func Median[S ~[]T, T Number](source S) (float64, bool) {
+	return Quantile(source, 0.5, InterpolationLinear)
+}
+
+// Mode returns the most frequent element of the slice, the first one to appear in case of a tie.
+// The ok result is false for an empty slice.
+func Mode[S ~[]T, T Number](source S) (result T, ok bool) {
+	result, _, ok = MostFrequent(source)
+	return result, ok
+}
+
+// Quantile returns the q-th quantile of the elements of the slice, for q in [0, 1].
+// The ok result is false for an empty slice or q out of range. The slice is not modified.
+func Quantile[S ~[]T, T Number](source S, q float64, method Interpolation) (float64, bool) {
+	var result = Quantiles(source, []float64{q}, method)
+	if result == nil || math.IsNaN(result[0]) {
+		return 0, false
+	}
+
+	return result[0], true
+}
+
+// Percentile returns the p-th percentile of the elements of the slice, for p in [0, 100].
+// The ok result is false for an empty slice or p out of range. The slice is not modified.
+func Percentile[S ~[]T, T Number](source S, p float64, method Interpolation) (float64, bool) {
+	return Quantile(source, p/100, method)
+}
+
+// Quantiles returns the quantiles of the elements of the slice for every q in qs, sorting the elements only once.
+// Quantiles for q out of [0, 1] are NaN. It returns nil for an empty slice. The slice is not modified.
+func Quantiles[S ~[]T, T Number](source S, qs []float64, method Interpolation) []float64 {
+	if len(source) == 0 {
+		return nil
+	}
+
+	var sorted = make([]float64, len(source))
+	for i, v := range source {
+		sorted[i] = float64(v)
+	}
+
+	slices.Sort(sorted)
+
+	var result = make([]float64, len(qs))
+	for i, q := range qs {
+		result[i] = quantile(sorted, q, method)
+	}
+
+	return result
+}
+
+func quantile(sorted []float64, q float64, method Interpolation) float64 {
+	if !(q >= 0 && q <= 1) {
+		return math.NaN()
+	}
+
+	var h = q * float64(len(sorted)-1)
+	var lo, hi = math.Floor(h), math.Ceil(h)
+	var low, high = sorted[int(lo)], sorted[int(hi)]
+
+	switch method {
+	case InterpolationLower:
+		return low
+	case InterpolationHigher:
+		return high
+	case InterpolationNearest:
+		return sorted[int(math.RoundToEven(h))]
+	case InterpolationMidpoint:
+		return (low + high) / 2
+	default:
+		return low + (h-lo)*(high-low)
+	}
+}
+
+// Variance returns the population variance of the elements of the slice. The ok result is false for an empty slice.
+func Variance[S ~[]T, T Number](source S) (float64, bool) {
+	return variance(source, 0)
+}
+
+// SampleVariance returns the sample variance of the elements of the slice, using Bessel's correction.
+// The ok result is false for slices with fewer than two elements.
+func SampleVariance[S ~[]T, T Number](source S) (float64, bool) {
+	return variance(source, 1)
+}
+
+// StdDev returns the population standard deviation of the elements of the slice.
+// The ok result is false for an empty slice.
+func StdDev[S ~[]T, T Number](source S) (float64, bool) {
+	var result, ok = Variance(source)
+	return math.Sqrt(result), ok
+}
+
+// SampleStdDev returns the sample standard deviation of the elements of the slice.
+// The ok result is false for slices with fewer than two elements.
+func SampleStdDev[S ~[]T, T Number](source S) (float64, bool) {
+	var result, ok = SampleVariance(source)
+	return math.Sqrt(result), ok
+}
+
+func variance[S ~[]T, T Number](source S, ddof int) (float64, bool) {
+	if len(source) <= ddof {
+		return 0, false
+	}
+
+	var mean, _ = Mean(source)
+
+	var result float64
+	for _, v := range source {
+		var d = float64(v) - mean
+		result += d * d
+	}
+
+	return result / float64(len(source)-ddof), true
+}
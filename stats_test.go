@@ -0,0 +1,194 @@
+package collection_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSum(t *testing.T) {
+	if got := collection.Sum([]int{1, 2, 3, 4}); got != 10 {
+		t.Errorf("Sum = %v; want 10", got)
+	}
+
+	if got := collection.Sum([]float64{}); got != 0 {
+		t.Errorf("Sum(empty) = %v; want 0", got)
+	}
+
+	type order struct{ total float64 }
+	orders := []order{{total: 1.5}, {total: 2.25}}
+	if got := collection.SumBy(orders, func(o order) float64 { return o.total }); got != 3.75 {
+		t.Errorf("SumBy = %v; want 3.75", got)
+	}
+}
+
+func TestCheckedSum(t *testing.T) {
+	cases := []struct {
+		name    string
+		source  []int8
+		want    int8
+		wantErr error
+	}{
+		{name: "empty", source: nil, want: 0},
+		{name: "fits", source: []int8{100, 27, -50}, want: 77},
+		{name: "positive overflow", source: []int8{100, 27, 1}, want: 127, wantErr: collection.ErrOverflow},
+		{name: "negative overflow", source: []int8{-100, -28, -1}, want: -128, wantErr: collection.ErrOverflow},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := collection.CheckedSum(tc.source)
+
+			if got != tc.want || !errors.Is(err, tc.wantErr) {
+				t.Errorf("CheckedSum(%v) = %v, %v; want %v, %v", tc.source, got, err, tc.want, tc.wantErr)
+			}
+		})
+	}
+
+	if _, err := collection.CheckedSum([]uint8{200, 56}); !errors.Is(err, collection.ErrOverflow) {
+		t.Errorf("CheckedSum(uint8) error = %v; want %v", err, collection.ErrOverflow)
+	}
+}
+
+func TestMeanMedianMode(t *testing.T) {
+	cases := []struct {
+		name   string
+		source []int
+		mean   float64
+		median float64
+		mode   int
+		ok     bool
+	}{
+		{name: "empty", source: nil},
+		{name: "odd", source: []int{3, 1, 2, 2, 7}, mean: 3, median: 2, mode: 2, ok: true},
+		{name: "even", source: []int{4, 1, 3, 2}, mean: 2.5, median: 2.5, mode: 4, ok: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, ok := collection.Mean(tc.source); !approxEqual(got, tc.mean) || ok != tc.ok {
+				t.Errorf("Mean(%v) = %v, %v; want %v, %v", tc.source, got, ok, tc.mean, tc.ok)
+			}
+
+			if got, ok := collection.Median(tc.source); !approxEqual(got, tc.median) || ok != tc.ok {
+				t.Errorf("Median(%v) = %v, %v; want %v, %v", tc.source, got, ok, tc.median, tc.ok)
+			}
+
+			if got, ok := collection.Mode(tc.source); got != tc.mode || ok != tc.ok {
+				t.Errorf("Mode(%v) = %v, %v; want %v, %v", tc.source, got, ok, tc.mode, tc.ok)
+			}
+		})
+	}
+}
+
+func TestAverageBy(t *testing.T) {
+	words := []string{"a", "abc", "ab"}
+
+	got, ok := collection.AverageBy(words, func(v string) int { return len(v) })
+	if !ok || got != 2 {
+		t.Errorf("AverageBy(%v) = %v, %v; want 2, true", words, got, ok)
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	source := []int{40, 10, 30, 20}
+
+	cases := []struct {
+		name   string
+		q      float64
+		method collection.Interpolation
+		want   float64
+		ok     bool
+	}{
+		{name: "min", q: 0, method: collection.InterpolationLinear, want: 10, ok: true},
+		{name: "max", q: 1, method: collection.InterpolationLinear, want: 40, ok: true},
+		{name: "linear", q: 0.4, method: collection.InterpolationLinear, want: 22, ok: true},
+		{name: "lower", q: 0.4, method: collection.InterpolationLower, want: 20, ok: true},
+		{name: "higher", q: 0.4, method: collection.InterpolationHigher, want: 30, ok: true},
+		{name: "nearest", q: 0.4, method: collection.InterpolationNearest, want: 20, ok: true},
+		{name: "nearest half to even", q: 0.5, method: collection.InterpolationNearest, want: 30, ok: true},
+		{name: "midpoint", q: 0.4, method: collection.InterpolationMidpoint, want: 25, ok: true},
+		{name: "below range", q: -0.1, method: collection.InterpolationLinear},
+		{name: "above range", q: 1.1, method: collection.InterpolationLinear},
+		{name: "nan", q: math.NaN(), method: collection.InterpolationLinear},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := collection.Quantile(source, tc.q, tc.method)
+
+			if !approxEqual(got, tc.want) || ok != tc.ok {
+				t.Errorf("Quantile(%v, %v) = %v, %v; want %v, %v", source, tc.q, got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+
+	if _, ok := collection.Quantile([]int{}, 0.5, collection.InterpolationLinear); ok {
+		t.Errorf("Quantile(empty) ok = true; want false")
+	}
+
+	if got, ok := collection.Percentile(source, 40, collection.InterpolationLinear); !ok || !approxEqual(got, 22) {
+		t.Errorf("Percentile(%v, 40) = %v, %v; want 22, true", source, got, ok)
+	}
+
+	if source[0] != 40 {
+		t.Errorf("Quantile modified the source: %v", source)
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	got := collection.Quantiles([]float64{5, 1, 4, 2, 3}, []float64{0.25, 0.5, 2}, collection.InterpolationLinear)
+
+	if len(got) != 3 || got[0] != 2 || got[1] != 3 || !math.IsNaN(got[2]) {
+		t.Errorf("Quantiles = %v; want [2 3 NaN]", got)
+	}
+
+	if got := collection.Quantiles([]float64{}, []float64{0.5}, collection.InterpolationLinear); got != nil {
+		t.Errorf("Quantiles(empty) = %v; want nil", got)
+	}
+}
+
+func TestVarianceStdDev(t *testing.T) {
+	source := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	if got, ok := collection.Variance(source); !ok || !approxEqual(got, 4) {
+		t.Errorf("Variance(%v) = %v, %v; want 4, true", source, got, ok)
+	}
+
+	if got, ok := collection.StdDev(source); !ok || !approxEqual(got, 2) {
+		t.Errorf("StdDev(%v) = %v, %v; want 2, true", source, got, ok)
+	}
+
+	if got, ok := collection.SampleVariance(source); !ok || !approxEqual(got, 32.0/7) {
+		t.Errorf("SampleVariance(%v) = %v, %v; want %v, true", source, got, ok, 32.0/7)
+	}
+
+	if got, ok := collection.SampleStdDev(source); !ok || !approxEqual(got, math.Sqrt(32.0/7)) {
+		t.Errorf("SampleStdDev(%v) = %v, %v; want %v, true", source, got, ok, math.Sqrt(32.0/7))
+	}
+
+	if _, ok := collection.Variance([]int{}); ok {
+		t.Errorf("Variance(empty) ok = true; want false")
+	}
+
+	if _, ok := collection.SampleVariance([]int{1}); ok {
+		t.Errorf("SampleVariance(single) ok = true; want false")
+	}
+}
+
+// ExamplePercentile: Example function demonstrating the use of the Percentile function.
+func ExamplePercentile() {
+	latencies := []int{120, 80, 95, 300, 110, 90, 105, 100, 85, 250}
+
+	p50, _ := collection.Percentile(latencies, 50, collection.InterpolationLinear)
+	p90, _ := collection.Percentile(latencies, 90, collection.InterpolationLower)
+	fmt.Println(p50, p90)
+	// Output: 102.5 250
+}
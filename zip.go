@@ -0,0 +1,89 @@
+package collection
+
+// Zip pairs up the elements of the first and second slices by index.
+// The result has the length of the shorter slice.
+func Zip[S1 ~[]T, S2 ~[]V, T, V any](first S1, second S2) []Pair[T, V] {
+	return ZipWith(first, second, func(t T, v V) Pair[T, V] {
+		return Pair[T, V]{First: t, Second: v}
+	})
+}
+
+// ZipWith combines the elements of the first and second slices by index using the provided combine function.
+// The result has the length of the shorter slice.
+func ZipWith[S1 ~[]T, S2 ~[]V, T, V, R any](first S1, second S2, combine func(T, V) R) []R {
+	var size = len(first)
+	if len(second) < size {
+		size = len(second)
+	}
+
+	var result = make([]R, size)
+	for i := range result {
+		result[i] = combine(first[i], second[i])
+	}
+
+	return result
+}
+
+// ZipLongest pairs up the elements of the first and second slices by index.
+// The result has the length of the longer slice, missing elements are replaced by the fill values.
+func ZipLongest[S1 ~[]T, S2 ~[]V, T, V any](first S1, second S2, fillFirst T, fillSecond V) []Pair[T, V] {
+	var size = len(first)
+	if len(second) > size {
+		size = len(second)
+	}
+
+	var result = make([]Pair[T, V], size)
+	for i := range result {
+		result[i] = Pair[T, V]{First: fillFirst, Second: fillSecond}
+
+		if i < len(first) {
+			result[i].First = first[i]
+		}
+
+		if i < len(second) {
+			result[i].Second = second[i]
+		}
+	}
+
+	return result
+}
+
+// Unzip splits the pairs into a slice of first values and a slice of second values.
+func Unzip[T, V any](pairs []Pair[T, V]) ([]T, []V) {
+	var (
+		first  = make([]T, len(pairs))
+		second = make([]V, len(pairs))
+	)
+
+	for i, p := range pairs {
+		first[i], second[i] = p.First, p.Second
+	}
+
+	return first, second
+}
+
+// ChannelsZip pairs up the values received from the first and second channels into 1 receive only channel.
+// The result channel is closed as soon as either input channel is closed.
+func ChannelsZip[T, V any](first <-chan T, second <-chan V) <-chan Pair[T, V] {
+	var result = make(chan Pair[T, V])
+
+	go func() {
+		defer close(result)
+
+		for {
+			var t, ok = <-first
+			if !ok {
+				return
+			}
+
+			v, ok := <-second
+			if !ok {
+				return
+			}
+
+			result <- Pair[T, V]{First: t, Second: v}
+		}
+	}()
+
+	return result
+}
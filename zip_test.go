@@ -0,0 +1,98 @@
+package collection_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/sergeydobrodey/collection"
+)
+
+func TestZip(t *testing.T) {
+	cases := []struct {
+		name   string
+		first  []int
+		second []string
+		want   []collection.Pair[int, string]
+	}{
+		{name: "empty", first: nil, second: nil, want: []collection.Pair[int, string]{}},
+		{name: "equal length", first: []int{1, 2}, second: []string{"a", "b"}, want: []collection.Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{name: "shorter second", first: []int{1, 2, 3}, second: []string{"a"}, want: []collection.Pair[int, string]{{1, "a"}}},
+		{name: "shorter first", first: []int{1}, second: []string{"a", "b"}, want: []collection.Pair[int, string]{{1, "a"}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := collection.Zip(tc.first, tc.second)
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("Zip(%v, %v) = %v; want %v", tc.first, tc.second, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	got := collection.ZipWith([]int{1, 2, 3}, []int{10, 20}, func(l, r int) int { return l + r })
+	want := []int{11, 22}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("ZipWith = %v; want %v", got, want)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	got := collection.ZipLongest([]int{1, 2, 3}, []string{"a"}, -1, "?")
+	want := []collection.Pair[int, string]{{1, "a"}, {2, "?"}, {3, "?"}}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("ZipLongest = %v; want %v", got, want)
+	}
+
+	got = collection.ZipLongest([]int{1}, []string{"a", "b"}, -1, "?")
+	want = []collection.Pair[int, string]{{1, "a"}, {-1, "b"}}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("ZipLongest = %v; want %v", got, want)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	first, second := collection.Unzip([]collection.Pair[int, string]{{1, "a"}, {2, "b"}})
+
+	if !slices.Equal(first, []int{1, 2}) || !slices.Equal(second, []string{"a", "b"}) {
+		t.Errorf("Unzip = %v, %v; want %v, %v", first, second, []int{1, 2}, []string{"a", "b"})
+	}
+}
+
+func TestChannelsZip(t *testing.T) {
+	first := make(chan int, 3)
+	second := make(chan string, 2)
+
+	for i := 1; i <= 3; i++ {
+		first <- i
+	}
+	second <- "a"
+	second <- "b"
+	close(first)
+	close(second)
+
+	got := drain(collection.ChannelsZip(first, second))
+	want := []collection.Pair[int, string]{{1, "a"}, {2, "b"}}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("ChannelsZip = %v; want %v", got, want)
+	}
+}
+
+func ExampleZipWith() {
+	ids := []int{1, 2}
+	names := []string{"Alice", "Bob"}
+
+	result := collection.ZipWith(ids, names, func(id int, name string) string {
+		return strconv.Itoa(id) + ":" + name
+	})
+	fmt.Println(result)
+	// Output: [1:Alice 2:Bob]
+}